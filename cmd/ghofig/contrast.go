@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/theme"
+)
+
+// runContrast prints the WCAG contrast ratio of each color pair in the
+// user's effective color scheme. Returns the process exit code: 1 if any
+// pair is below the threshold.
+func runContrast(args []string) int {
+	fs := flag.NewFlagSet("contrast", flag.ExitOnError)
+	threshold := fs.Float64("threshold", theme.ContrastAA, "minimum contrast ratio (3 = AA large text, 4.5 = AA, 7 = AAA)")
+	light := fs.Bool("light", false, "use the light variant of a light:x,dark:y theme")
+	failingOnly := fs.Bool("failing", false, "only list pairs below the threshold")
+	fs.Parse(args)
+
+	if *threshold < 1 || *threshold > 21 {
+		fmt.Fprintln(os.Stderr, "--threshold must be between 1 and 21")
+		return 2
+	}
+
+	entries, err := config.LoadEntries()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read config: %v\n", err)
+		return 2
+	}
+
+	colors, err := theme.Resolve(entries, !*light)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to resolve colors: %v\n", err)
+		return 2
+	}
+
+	pairs := theme.Contrast(colors)
+	failing := theme.Failing(pairs, *threshold)
+	for _, p := range pairs {
+		status := "pass"
+		if !p.Passes(*threshold) {
+			status = "FAIL"
+		} else if *failingOnly {
+			continue
+		}
+		fmt.Printf("%s %5.2f:1  %-12s %s on %s\n", status, p.Ratio, p.Name, p.Foreground.Hex(), p.Background.Hex())
+	}
+
+	fmt.Printf("\n%d of %d pairs below %.1f:1\n", len(failing), len(pairs), *threshold)
+	if len(failing) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/theme"
+)
+
+// runExportTheme prints the user's effective colors in another terminal's
+// format. Returns the process exit code.
+func runExportTheme(args []string) int {
+	fs := flag.NewFlagSet("export-theme", flag.ExitOnError)
+	format := fs.String("format", "", "output format: "+strings.Join(theme.Formats(), ", "))
+	name := fs.String("name", "", "scheme name (default: the theme name)")
+	light := fs.Bool("light", false, "use the light variant of a light:x,dark:y theme")
+	output := fs.String("output", "", "file to write to (default: stdout)")
+	fs.Parse(args)
+
+	if *format == "" {
+		fmt.Fprintf(os.Stderr, "Usage: ghofig export-theme --format <%s>\n", strings.Join(theme.Formats(), "|"))
+		return 2
+	}
+
+	entries, err := config.LoadEntries()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read config: %v\n", err)
+		return 1
+	}
+
+	colors, err := theme.Resolve(entries, !*light)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to resolve colors: %v\n", err)
+		return 1
+	}
+	if *name != "" {
+		colors.Name = *name
+	}
+
+	out, err := theme.Export(colors, *format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 2
+	}
+
+	if *output == "" {
+		fmt.Print(out)
+		return 0
+	}
+	if err := os.WriteFile(*output, []byte(out), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write %s: %v\n", *output, err)
+		return 1
+	}
+	return 0
+}
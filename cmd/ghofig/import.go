@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/diff"
+	"github.com/intaek-h/ghofig/internal/importer"
+)
+
+// importers maps a source format to its importer.
+var importers = map[string]func(path string) (importer.Result, error){
+	"alacritty": func(path string) (importer.Result, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return importer.Result{}, err
+		}
+		defer file.Close()
+		return importer.Alacritty(file)
+	},
+	"kitty": func(path string) (importer.Result, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return importer.Result{}, err
+		}
+		defer file.Close()
+		return importer.Kitty(file, path)
+	},
+}
+
+// runImport translates another terminal's config, shows the resulting
+// diff of the user's Ghostty config and applies it once confirmed.
+// Returns the process exit code.
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "show the changes without applying them")
+	yes := fs.Bool("yes", false, "apply the changes without asking")
+	positional := parseInterspersed(fs, args)
+
+	if len(positional) != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: ghofig import [--dry-run] [--yes] <alacritty|kitty> <path>")
+		return 2
+	}
+
+	format, path := positional[0], positional[1]
+	importFn, ok := importers[format]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown import format: %s\n", format)
+		return 2
+	}
+
+	result, err := importFn(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to import %s: %v\n", path, err)
+		return 1
+	}
+
+	printImportResult(result)
+	if len(result.Settings) == 0 {
+		return 0
+	}
+
+	current, err := config.ReadFile()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read config: %v\n", err)
+		return 1
+	}
+	configPath, _ := config.GetConfigPath()
+
+	fmt.Printf("\nChanges to %s:\n", configPath)
+	fmt.Print(diff.Unified(diff.Lines(current, config.ApplyLines(current, result.Lines())), 2))
+
+	if *dryRun {
+		return 0
+	}
+	if !*yes && !confirm("\nApply these changes?") {
+		fmt.Println("Nothing was changed.")
+		return 0
+	}
+
+	if err := config.AppendLines(result.Lines()); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write config: %v\n", err)
+		return 1
+	}
+	fmt.Println("Config updated.")
+	return 0
+}
+
+// printImportResult lists what was imported and what was left out.
+func printImportResult(result importer.Result) {
+	fmt.Printf("Imported %d settings:\n", len(result.Settings))
+	for _, s := range result.Settings {
+		fmt.Printf("  %-40s (from %s)\n", s.Line(), s.Source)
+	}
+
+	if len(result.Unmapped) > 0 {
+		fmt.Printf("\nCould not map %d settings:\n", len(result.Unmapped))
+		for _, u := range result.Unmapped {
+			fmt.Printf("  %s: %s\n", u.Source, u.Reason)
+		}
+	}
+}
+
+// confirm asks a yes/no question on stdin. Defaults to no.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// parseInterspersed parses flags that may appear before, between or after
+// positional arguments, and returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
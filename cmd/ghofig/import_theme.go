@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/theme"
+)
+
+// runImportTheme converts a color scheme from another terminal into a
+// Ghostty theme file. Returns the process exit code.
+func runImportTheme(args []string) int {
+	fs := flag.NewFlagSet("import-theme", flag.ExitOnError)
+	format := fs.String("format", "", "scheme format: base16, iterm2, xresources (default: from the file name)")
+	name := fs.String("name", "", "theme name (default: the scheme or file name)")
+	apply := fs.Bool("apply", false, "set `theme = <name>` in the config")
+	force := fs.Bool("force", false, "replace an existing theme of the same name")
+	positional := parseInterspersed(fs, args)
+
+	if len(positional) != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: ghofig import-theme [--format <base16|iterm2|xresources>] [--name <name>] [--apply] [--force] <path>")
+		return 2
+	}
+	path := positional[0]
+
+	if *format == "" {
+		detected, err := theme.DetectFormat(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 2
+		}
+		*format = detected
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open %s: %v\n", path, err)
+		return 1
+	}
+	colors, err := theme.Import(file, *format)
+	file.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to import %s: %v\n", path, err)
+		return 1
+	}
+
+	themeName := *name
+	if themeName == "" {
+		themeName = themeNameFor(colors.Name, path)
+	}
+
+	themePath, err := theme.Install(themeName, colors, *force)
+	if errors.Is(err, os.ErrExist) {
+		fmt.Fprintf(os.Stderr, "%v, use --force to replace it or --name to pick another name\n", err)
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write theme: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Wrote %s\n", themePath)
+
+	if !*apply {
+		fmt.Printf("Add `theme = %s` to your config to use it.\n", themeName)
+		return 0
+	}
+	if err := config.AppendLine("theme = " + themeName); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write config: %v\n", err)
+		return 1
+	}
+	fmt.Println("Config updated.")
+	return 0
+}
+
+// themeNameFor picks a theme name from the scheme's own name, falling back
+// to the file name without its extension.
+func themeNameFor(schemeName, path string) string {
+	if schemeName != "" && schemeName != theme.Default().Name {
+		return strings.ReplaceAll(schemeName, string(filepath.Separator), "-")
+	}
+	base := filepath.Base(path)
+	return strings.TrimPrefix(strings.TrimSuffix(base, filepath.Ext(base)), ".")
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+
+	ghofig "github.com/intaek-h/ghofig"
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/db"
+	"github.com/intaek-h/ghofig/internal/ghostty"
+	"github.com/intaek-h/ghofig/internal/keybind"
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+// runLint checks the user's config and prints one line per finding.
+// Returns the process exit code: 1 if any warnings were found.
+func runLint(args []string) int {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	configPath := fs.String("config", "", "config file to lint (default: your Ghostty config)")
+	defaultsPath := fs.String("keybind-defaults", "", "output of `ghostty +list-keybinds --default` to compare against")
+	versionFlag := fs.String("ghostty-version", "", "Ghostty version to check options against (default: the installed one)")
+	fs.Parse(args)
+
+	installed, err := ghosttyVersion(*versionFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid --ghostty-version: %v\n", err)
+		return 2
+	}
+
+	if *configPath == "" {
+		path, err := config.GetConfigPath()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to find config: %v\n", err)
+			return 2
+		}
+		*configPath = path
+	}
+
+	entries, err := config.LoadEntriesFrom(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read config: %v\n", err)
+		return 2
+	}
+
+	defaults, err := loadKeybindDefaults(*defaultsPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read keybind defaults: %v\n", err)
+		return 2
+	}
+
+	if err := db.Init(ghofig.EmbeddedDB); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize database: %v\n", err)
+		return 2
+	}
+	defer db.Close()
+	useInstalledDocs()
+
+	newest, err := db.GetAll()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read options: %v\n", err)
+		return 2
+	}
+
+	// Check against the docs of the installed version, so options removed
+	// since aren't reported as unknown
+	if err := selectDocs(installed, ""); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to select docs: %v\n", err)
+		return 2
+	}
+
+	options, err := db.GetAll()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read options: %v\n", err)
+		return 2
+	}
+
+	warnings := 0
+	for _, problem := range checkOptions(entries, options, newest, installed) {
+		fmt.Println(problem)
+		warnings++
+	}
+
+	report := keybind.Check(entries, defaults)
+	for _, c := range report.Conflicts {
+		level := "info"
+		if c.Kind.IsWarning() {
+			level = "warning"
+		}
+		fmt.Printf("%s: %s: keybind %s: %s\n", c.Binding.Location(), level, c.Kind, c.Message)
+	}
+
+	if warnings+report.Warnings() > 0 {
+		return 1
+	}
+	return 0
+}
+
+// checkOptions finds config lines the installed Ghostty would reject:
+// options it doesn't know, either because they don't exist or because
+// they were added in a later version. options are the installed version's
+// docs and newest the newest docs, which options added later are found in.
+func checkOptions(entries []config.Entry, options, newest []model.Config, installed ghostty.Version) []string {
+	byTitle := make(map[string]model.Config, len(options))
+	for _, o := range options {
+		byTitle[o.Title] = o
+	}
+	newer := make(map[string]model.Config, len(newest))
+	for _, o := range newest {
+		if _, ok := byTitle[o.Title]; !ok {
+			newer[o.Title] = o
+		}
+	}
+
+	var problems []string
+	for _, e := range entries {
+		option, ok := byTitle[e.Key]
+		if !ok {
+			// Options added later without a documented version are unknown
+			// to the installed Ghostty all the same
+			option, ok = newer[e.Key]
+			ok = ok && option.Since != ""
+		}
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: warning: unknown option %q", e.Location(), e.Key))
+		case !installed.Supports(option.Since):
+			problems = append(problems, fmt.Sprintf("%s: warning: %s requires Ghostty %s, installed is %s", e.Location(), e.Key, option.Since, installed))
+		}
+	}
+	return problems
+}
+
+// loadKeybindDefaults reads a defaults dump, or falls back to the bundled
+// table for the current platform.
+func loadKeybindDefaults(path string) ([]keybind.Binding, error) {
+	if path == "" {
+		return keybind.Defaults(runtime.GOOS), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return keybind.ParseDefaults(file)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
@@ -15,11 +16,39 @@ import (
 var version = "dev"
 
 func main() {
-	// Handle version flag
-	if len(os.Args) > 1 && (os.Args[1] == "-v" || os.Args[1] == "--version") {
-		fmt.Printf("ghofig %s\n", version)
-		return
+	// Handle version flag and subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "-v", "--version":
+			fmt.Printf("ghofig %s\n", version)
+			return
+		case "lint":
+			os.Exit(runLint(os.Args[2:]))
+		case "import":
+			os.Exit(runImport(os.Args[2:]))
+		case "export-theme":
+			os.Exit(runExportTheme(os.Args[2:]))
+		case "import-theme":
+			os.Exit(runImportTheme(os.Args[2:]))
+		case "contrast":
+			os.Exit(runContrast(os.Args[2:]))
+		}
 	}
+
+	// Flags for the TUI itself
+	fs := flag.NewFlagSet("ghofig", flag.ExitOnError)
+	versionFlag := fs.String("ghostty-version", "", "Ghostty version to check options against (default: the installed one)")
+	docsFlag := fs.String("docs-version", "", "Ghostty version to show the docs of (default: the installed one, or the newest)")
+	embeddedDocs := fs.Bool("embedded-docs", false, "don't read the docs of the installed Ghostty")
+	fs.Parse(os.Args[1:])
+
+	installed, err := ghosttyVersion(*versionFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid --ghostty-version: %v\n", err)
+		os.Exit(2)
+	}
+	tui.SetGhosttyVersion(installed)
+
 	// Initialize database from embedded bytes
 	if err := db.Init(ghofig.EmbeddedDB); err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize database: %v\n", err)
@@ -27,6 +56,16 @@ func main() {
 	}
 	defer db.Close()
 
+	// Prefer the installed Ghostty's own docs when they're newer
+	if !*embeddedDocs {
+		useInstalledDocs()
+	}
+
+	if err := selectDocs(installed, *docsFlag); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid --docs-version: %v\n", err)
+		os.Exit(2)
+	}
+
 	// Create and run the TUI
 	p := tea.NewProgram(
 		tui.New(),
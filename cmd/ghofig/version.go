@@ -0,0 +1,87 @@
+package main
+
+import (
+	"github.com/intaek-h/ghofig/internal/db"
+	"github.com/intaek-h/ghofig/internal/docparse"
+	"github.com/intaek-h/ghofig/internal/ghostty"
+)
+
+// ghosttyVersion returns the Ghostty version to check options against:
+// the one given with --ghostty-version, or the installed one. Returns the
+// zero version, which allows every option, when neither is known.
+func ghosttyVersion(flagValue string) (ghostty.Version, error) {
+	if flagValue != "" {
+		return ghostty.ParseVersion(flagValue)
+	}
+	v, err := ghostty.Detect()
+	if err != nil {
+		return ghostty.Version{}, nil // Ghostty isn't installed here
+	}
+	return v, nil
+}
+
+// docsVersionFor picks the newest documented version that an installed
+// Ghostty has: the one with the same or an older major.minor. Returns ""
+// when the installed version is unknown or older than every docs version.
+func docsVersionFor(installed ghostty.Version, versions []string) string {
+	if installed.IsZero() {
+		return ""
+	}
+
+	best := ""
+	for _, v := range versions {
+		docs, err := ghostty.ParseVersion(v)
+		if err != nil {
+			continue
+		}
+		if docs.Major < installed.Major || (docs.Major == installed.Major && docs.Minor <= installed.Minor) {
+			best = v
+		}
+	}
+	return best
+}
+
+// selectDocs selects the docs to query: docsVersion if given, otherwise
+// the ones matching the installed Ghostty. The newest docs stay selected
+// when neither is known.
+func selectDocs(installed ghostty.Version, docsVersion string) error {
+	if docsVersion == "" {
+		versions, _ := db.Versions()
+		docsVersion = docsVersionFor(installed, versions)
+	}
+	if docsVersion == "" {
+		return nil
+	}
+	return db.SetVersion(docsVersion)
+}
+
+// useInstalledDocs loads the docs of the installed Ghostty into the
+// database and selects them, when that Ghostty is newer than every
+// embedded docs version. The version is always detected, never taken
+// from --ghostty-version, as the docs are read from the Ghostty on PATH
+// and are stored under its version. Failing to find or read them is not
+// an error: the embedded docs are used instead.
+func useInstalledDocs() {
+	installed, err := ghostty.Detect()
+	if err != nil || installed.IsZero() {
+		return
+	}
+
+	versions, err := db.Versions()
+	if err != nil || len(versions) == 0 {
+		return
+	}
+	newest, err := ghostty.ParseVersion(versions[len(versions)-1])
+	if err != nil || !newest.Less(ghostty.Version{Major: installed.Major, Minor: installed.Minor}) {
+		return
+	}
+
+	entries, _, err := ghostty.LoadDocs()
+	if err != nil {
+		return
+	}
+	if err := db.AddDocs(installed.Series(), docparse.Options(entries)); err != nil {
+		return
+	}
+	db.SetVersion(installed.Series())
+}
@@ -1,113 +1,104 @@
 package main
 
 import (
-	"bufio"
 	"database/sql"
+	"flag"
 	"fmt"
+	"maps"
 	"os"
-	"regexp"
+	"slices"
 	"strings"
 
+	ghofigdb "github.com/intaek-h/ghofig/internal/db"
+	"github.com/intaek-h/ghofig/internal/docparse"
 	_ "modernc.org/sqlite"
 )
 
-const (
-	inputFile  = "reference.mdx.txt"
-	outputFile = "data/ghofig.db"
-)
+// defaultReferences are the option references ingested when no
+// --reference flag is given, one per Ghostty version, oldest first.
+var defaultReferences = []reference{
+	{version: "1.2", file: "reference.mdx.txt"},
+}
+
+// reference is the option reference of one Ghostty version.
+type reference struct {
+	version string
+	file    string
+	options []docparse.Option
+}
 
-// h2Pattern matches lines like: ## `config-name`
-var h2Pattern = regexp.MustCompile("^## `(.+)`$")
+// referenceFlags collects repeated --reference version=file flags.
+type referenceFlags []reference
 
-type configEntry struct {
-	title       string
-	description string
+func (r *referenceFlags) String() string {
+	var parts []string
+	for _, ref := range *r {
+		parts = append(parts, ref.version+"="+ref.file)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (r *referenceFlags) Set(value string) error {
+	version, file, ok := strings.Cut(value, "=")
+	if !ok || version == "" || file == "" {
+		return fmt.Errorf("want version=file, got %q", value)
+	}
+	*r = append(*r, reference{version: version, file: file})
+	return nil
 }
 
 func main() {
-	entries, err := parseFile(inputFile)
+	var references referenceFlags
+	flags := flag.NewFlagSet("parser", flag.ExitOnError)
+	flags.Var(&references, "reference", "option reference as `version=file`, repeat oldest first (default 1.2 from the repo root)")
+	actionsFile := flags.String("actions", "keybind-reference.mdx.txt", "keybind action reference")
+	outputFile := flags.String("output", "data/ghofig.db", "database to write")
+	flags.Parse(os.Args[1:])
+
+	if len(references) == 0 {
+		references = append(references, defaultReferences...)
+	}
+
+	for i := range references {
+		entries, err := parseFile(references[i].file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing file: %v\n", err)
+			os.Exit(1)
+		}
+		references[i].options = docparse.Options(entries)
+
+		fmt.Printf("Parsed %d config entries for %s\n", len(entries), references[i].version)
+	}
+
+	actionDocs, err := parseFile(*actionsFile)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing file: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error parsing actions file: %v\n", err)
 		os.Exit(1)
 	}
+	actions := docparse.Actions(actionDocs)
 
-	fmt.Printf("Parsed %d config entries\n", len(entries))
+	fmt.Printf("Parsed %d keybind actions\n", len(actions))
 
-	if err := writeDatabase(outputFile, entries); err != nil {
+	if err := writeDatabase(*outputFile, references, actions); err != nil {
 		fmt.Fprintf(os.Stderr, "Error writing database: %v\n", err)
 		os.Exit(1)
 	}
 
-	fmt.Printf("Database written to %s\n", outputFile)
+	fmt.Printf("Database written to %s\n", *outputFile)
 }
 
-func parseFile(filename string) ([]configEntry, error) {
+// parseFile reads a reference page.
+func parseFile(filename string) ([]docparse.Entry, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	var entries []configEntry
-	var pendingTitles []string
-	var descriptionLines []string
-	inDescription := false
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		// Check if line is an h2 header
-		if match := h2Pattern.FindStringSubmatch(line); match != nil {
-			// If we were building a description, flush it
-			if inDescription && len(pendingTitles) > 0 {
-				description := strings.TrimSpace(strings.Join(descriptionLines, "\n"))
-				for _, title := range pendingTitles {
-					entries = append(entries, configEntry{
-						title:       title,
-						description: description,
-					})
-				}
-				pendingTitles = nil
-				descriptionLines = nil
-			}
-
-			// Add this title to pending
-			pendingTitles = append(pendingTitles, match[1])
-			inDescription = false
-			continue
-		}
-
-		// If we have pending titles and hit non-empty content, start description
-		if len(pendingTitles) > 0 {
-			// Skip empty lines between h2 and description start
-			if !inDescription && strings.TrimSpace(line) == "" {
-				continue
-			}
-			inDescription = true
-			descriptionLines = append(descriptionLines, line)
-		}
-	}
-
-	// Flush any remaining entry
-	if len(pendingTitles) > 0 && len(descriptionLines) > 0 {
-		description := strings.TrimSpace(strings.Join(descriptionLines, "\n"))
-		for _, title := range pendingTitles {
-			entries = append(entries, configEntry{
-				title:       title,
-				description: description,
-			})
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-
-	return entries, nil
+	return docparse.Parse(file)
 }
 
-func writeDatabase(filename string, entries []configEntry) error {
+func writeDatabase(filename string, references []reference, actions []docparse.Action) error {
 	// Remove existing database
 	os.Remove(filename)
 
@@ -121,24 +112,106 @@ func writeDatabase(filename string, entries []configEntry) error {
 	_, err = db.Exec(`
 		CREATE TABLE IF NOT EXISTS configs (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			version TEXT NOT NULL,
 			title TEXT NOT NULL,
+			description TEXT NOT NULL,
+			category TEXT NOT NULL,
+			platforms TEXT NOT NULL,
+			since TEXT NOT NULL,
+			type TEXT NOT NULL,
+			group_id INTEGER REFERENCES groups(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_configs_title ON configs(version, title);
+		CREATE INDEX IF NOT EXISTS idx_configs_category ON configs(version, category);
+		CREATE INDEX IF NOT EXISTS idx_configs_group ON configs(group_id);
+
+		CREATE TABLE IF NOT EXISTS groups (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			version TEXT NOT NULL,
+			name TEXT NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS versions (
+			name TEXT PRIMARY KEY,
+			position INTEGER NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS categories (
+			name TEXT PRIMARY KEY,
+			position INTEGER NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS "references" (
+			config_id INTEGER NOT NULL REFERENCES configs(id),
+			target_id INTEGER NOT NULL REFERENCES configs(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_references_config ON "references"(config_id);
+		CREATE INDEX IF NOT EXISTS idx_references_target ON "references"(target_id);
+
+		CREATE TABLE IF NOT EXISTS similar (
+			config_id INTEGER NOT NULL REFERENCES configs(id),
+			target_id INTEGER NOT NULL REFERENCES configs(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_similar_config ON similar(config_id);
+
+		CREATE TABLE IF NOT EXISTS synonyms (
+			phrase TEXT NOT NULL,
+			title TEXT NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS idx_synonyms_phrase ON synonyms(phrase);
+
+		CREATE TABLE IF NOT EXISTS actions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			parameters TEXT NOT NULL,
 			description TEXT NOT NULL
 		);
-		CREATE INDEX IF NOT EXISTS idx_configs_title ON configs(title);
+		CREATE INDEX IF NOT EXISTS idx_actions_name ON actions(name);
 	`)
 	if err != nil {
 		return err
 	}
 
-	// Insert entries
-	stmt, err := db.Prepare("INSERT INTO configs (title, description) VALUES (?, ?)")
+	for i, ref := range references {
+		if _, err := db.Exec("INSERT INTO versions (name, position) VALUES (?, ?)", ref.version, i); err != nil {
+			return err
+		}
+		if err := ghofigdb.InsertOptions(db, ref.version, ref.options); err != nil {
+			return err
+		}
+	}
+
+	for i, category := range docparse.Categories {
+		if _, err := db.Exec("INSERT INTO categories (name, position) VALUES (?, ?)", category, i); err != nil {
+			return err
+		}
+	}
+
+	// Synonyms name options by title, so they apply to every version
+	latest := map[string]bool{}
+	for _, o := range references[len(references)-1].options {
+		latest[o.Title] = true
+	}
+	phrases := slices.Sorted(maps.Keys(docparse.Synonyms))
+	for _, phrase := range phrases {
+		for _, title := range docparse.Synonyms[phrase] {
+			if !latest[title] {
+				fmt.Fprintf(os.Stderr, "Warning: synonym %q names unknown option %s\n", phrase, title)
+			}
+			if _, err := db.Exec("INSERT INTO synonyms (phrase, title) VALUES (?, ?)", phrase, title); err != nil {
+				return err
+			}
+		}
+	}
+
+	actionStmt, err := db.Prepare("INSERT INTO actions (name, parameters, description) VALUES (?, ?, ?)")
 	if err != nil {
 		return err
 	}
-	defer stmt.Close()
+	defer actionStmt.Close()
 
-	for _, entry := range entries {
-		_, err = stmt.Exec(entry.title, entry.description)
+	for _, action := range actions {
+		_, err = actionStmt.Exec(action.Name, action.Parameters, action.Description)
 		if err != nil {
 			return err
 		}
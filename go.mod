@@ -3,9 +3,11 @@ module github.com/intaek-h/ghofig
 go 1.24.0
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/charmbracelet/bubbles v0.21.0
 	github.com/charmbracelet/bubbletea v1.3.10
 	github.com/charmbracelet/lipgloss v1.1.0
+	gopkg.in/yaml.v3 v3.0.1
 	modernc.org/sqlite v1.44.1
 )
 
@@ -42,6 +42,14 @@ func getXDGConfigPath(home string) string {
 // If the same option already exists, it comments out the old line(s) first.
 // Creates the file and parent directories if they don't exist.
 func AppendLine(line string) error {
+	return AppendLines([]string{line})
+}
+
+// AppendLines appends several lines to the config file at once.
+// Existing lines for any option being set are commented out first, except
+// for options like `keybind` where each line adds an entry of its own.
+// Creates the file and parent directories if they don't exist.
+func AppendLines(lines []string) error {
 	configPath, err := GetConfigPath()
 	if err != nil {
 		return err
@@ -53,29 +61,40 @@ func AppendLine(line string) error {
 		return err
 	}
 
-	// Extract option name from the new line
-	optionName := ""
-	if parts := strings.SplitN(line, "=", 2); len(parts) == 2 {
-		optionName = strings.TrimSpace(parts[0])
-	}
-
-	// Read existing content and comment out matching options
 	data, err := os.ReadFile(configPath)
 	if err != nil && !os.IsNotExist(err) {
 		return err
 	}
 
+	// Write the entire file back
+	return os.WriteFile(configPath, []byte(ApplyLines(string(data), lines)), 0644)
+}
+
+// ApplyLines returns content with lines appended the way AppendLines
+// would write them, without touching the file.
+func ApplyLines(content string, lines []string) string {
+	// Extract the names of options being replaced from the new lines
+	optionNames := map[string]bool{}
+	for _, line := range lines {
+		if parts := strings.SplitN(line, "=", 2); len(parts) == 2 {
+			if key := strings.TrimSpace(parts[0]); !additive[key] {
+				optionNames[key] = true
+			}
+		}
+	}
+
+	// Comment out matching options in the existing content
 	var newContent strings.Builder
-	if len(data) > 0 {
-		lines := strings.Split(string(data), "\n")
-		for i, existingLine := range lines {
+	if len(content) > 0 {
+		existingLines := strings.Split(content, "\n")
+		for i, existingLine := range existingLines {
 			trimmed := strings.TrimSpace(existingLine)
 
 			// Check if this line sets the same option (and isn't already commented)
-			if optionName != "" && !strings.HasPrefix(trimmed, "#") {
+			if !strings.HasPrefix(trimmed, "#") {
 				if parts := strings.SplitN(trimmed, "=", 2); len(parts) == 2 {
 					key := strings.TrimSpace(parts[0])
-					if key == optionName {
+					if optionNames[key] {
 						// Comment out this line
 						existingLine = "# " + existingLine
 					}
@@ -84,23 +103,23 @@ func AppendLine(line string) error {
 
 			newContent.WriteString(existingLine)
 			// Add newline except for last line if it was empty
-			if i < len(lines)-1 {
+			if i < len(existingLines)-1 {
 				newContent.WriteString("\n")
 			}
 		}
 	}
 
 	// Ensure trailing newline before appending
-	content := newContent.String()
-	if len(content) > 0 && !strings.HasSuffix(content, "\n") {
-		content += "\n"
+	result := newContent.String()
+	if len(result) > 0 && !strings.HasSuffix(result, "\n") {
+		result += "\n"
 	}
 
-	// Append the new line
-	content += line + "\n"
-
-	// Write the entire file back
-	return os.WriteFile(configPath, []byte(content), 0644)
+	// Append the new lines
+	for _, line := range lines {
+		result += line + "\n"
+	}
+	return result
 }
 
 // ConfigExists checks if a config file exists at any known location
@@ -0,0 +1,38 @@
+package config
+
+import "testing"
+
+func TestApplyLines(t *testing.T) {
+	content := "font-size = 12\nkeybind = ctrl+a=select_all\n# theme = old\ntheme = dracula"
+	lines := []string{"keybind = ctrl+t=new_tab", "theme = nord", "font-size = 14"}
+
+	want := `# font-size = 12
+keybind = ctrl+a=select_all
+# theme = old
+# theme = dracula
+keybind = ctrl+t=new_tab
+theme = nord
+font-size = 14
+`
+	if got := ApplyLines(content, lines); got != want {
+		t.Errorf("ApplyLines =\n%s\nwant\n%s", got, want)
+	}
+
+	// A new font-family replaces the list, while palette entries add up
+	content = "font-family = Old Mono\nfont-family = Fallback Mono\npalette = 0=#000000"
+	lines = []string{"font-family = JetBrains Mono", "palette = 1=#ff0000"}
+
+	want = `# font-family = Old Mono
+# font-family = Fallback Mono
+palette = 0=#000000
+font-family = JetBrains Mono
+palette = 1=#ff0000
+`
+	if got := ApplyLines(content, lines); got != want {
+		t.Errorf("ApplyLines =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := ApplyLines("", []string{"theme = nord"}); got != "theme = nord\n" {
+		t.Errorf("ApplyLines on empty config = %q", got)
+	}
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Entry is a single "key = value" line from a config file.
+type Entry struct {
+	Key   string
+	Value string
+	File  string
+	Line  int
+}
+
+// Location returns the "file:line" the entry comes from.
+func (e Entry) Location() string {
+	return e.File + ":" + strconv.Itoa(e.Line)
+}
+
+// Parse reads config lines from r. The file name is only used to annotate
+// the returned entries. Comments and blank lines are skipped, and values
+// wrapped in double quotes are unquoted.
+func Parse(r io.Reader, file string) ([]Entry, error) {
+	var entries []Entry
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Key:   strings.TrimSpace(parts[0]),
+			Value: unquote(strings.TrimSpace(parts[1])),
+			File:  file,
+			Line:  lineNum,
+		})
+	}
+
+	return entries, scanner.Err()
+}
+
+// LoadEntries parses the user's config file and any files it pulls in
+// through `config-file`, in the order Ghostty applies them.
+// Returns no entries if the config file doesn't exist.
+func LoadEntries() ([]Entry, error) {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	return LoadEntriesFrom(configPath)
+}
+
+// LoadEntriesFrom parses the config file at path and follows its
+// `config-file` includes. Included files are applied after the file that
+// references them, and each file is only loaded once.
+// Returns no entries if the file doesn't exist.
+func LoadEntriesFrom(path string) ([]Entry, error) {
+	return loadEntries(path, true, map[string]bool{})
+}
+
+func loadEntries(path string, optional bool, seen map[string]bool) ([]Entry, error) {
+	if seen[path] {
+		return nil, nil
+	}
+	seen[path] = true
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && optional {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	entries, err := Parse(file, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ghostty loads config-file includes after the current file is done
+	var result []Entry
+	var includes []string
+	for _, e := range entries {
+		if e.Key == "config-file" && e.Value != "" {
+			includes = append(includes, e.Value)
+		}
+		result = append(result, e)
+	}
+
+	for _, include := range includes {
+		optional := strings.HasPrefix(include, "?")
+		include = strings.TrimPrefix(include, "?")
+		include = unquote(include)
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(path), include)
+		}
+
+		included, err := loadEntries(include, optional, seen)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, included...)
+	}
+
+	return result, nil
+}
+
+// unquote strips a single pair of surrounding double quotes.
+func unquote(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// repeatable are the options Ghostty accumulates instead of overriding:
+// each line adds a value, and an empty value clears the list.
+var repeatable = map[string]bool{
+	"font-family":                true,
+	"font-family-bold":           true,
+	"font-family-italic":         true,
+	"font-family-bold-italic":    true,
+	"font-feature":               true,
+	"font-variation":             true,
+	"font-variation-bold":        true,
+	"font-variation-italic":      true,
+	"font-variation-bold-italic": true,
+	"font-codepoint-map":         true,
+	"keybind":                    true,
+	"palette":                    true,
+	"config-file":                true,
+	"custom-shader":              true,
+	"env":                        true,
+	"link":                       true,
+	"command-palette-entry":      true,
+}
+
+// additive are the repeatable options whose lines are entries of their
+// own, like one keybind or palette color each. The other repeatable
+// options, like font-family, are ordered lists that a new value replaces.
+var additive = map[string]bool{
+	"font-codepoint-map":    true,
+	"keybind":               true,
+	"palette":               true,
+	"config-file":           true,
+	"env":                   true,
+	"link":                  true,
+	"command-palette-entry": true,
+}
+
+// Effective returns the entries that are in effect once Ghostty has
+// applied them in order: the last line of each option, or every line
+// since the last reset for repeatable options. Options set to an empty
+// value are back at their default and left out. Entries are ordered by
+// where each option first appears.
+func Effective(entries []Entry) []Entry {
+	var order []string
+	values := map[string][]Entry{}
+	for _, e := range entries {
+		if _, ok := values[e.Key]; !ok {
+			order = append(order, e.Key)
+		}
+		switch {
+		case e.Value == "":
+			values[e.Key] = []Entry{}
+		case repeatable[e.Key]:
+			values[e.Key] = append(values[e.Key], e)
+		default:
+			values[e.Key] = []Entry{e}
+		}
+	}
+
+	var result []Entry
+	for _, key := range order {
+		result = append(result, values[key]...)
+	}
+	return result
+}
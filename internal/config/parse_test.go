@@ -0,0 +1,38 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEffective(t *testing.T) {
+	input := `font-size = 12
+palette = 0=#000000
+theme = dracula
+font-size = 14
+palette = 1=#ff0000
+theme =
+keybind = ctrl+a=new_tab
+keybind =
+keybind = ctrl+b=new_window
+`
+	entries, err := Parse(strings.NewReader(input), "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range Effective(entries) {
+		got = append(got, e.Key+"="+e.Value)
+	}
+
+	want := []string{
+		"font-size=14",
+		"palette=0=#000000",
+		"palette=1=#ff0000",
+		"keybind=ctrl+b=new_window",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Effective() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+// searchCacheSize is how many recent search results are kept.
+const searchCacheSize = 32
+
+// searches caches recent search results, as typing and deleting in the
+// search view repeats the same queries.
+var searches = newSearchCache(searchCacheSize)
+
+// searchKey identifies a search: the same query gives different results
+// for different versions.
+type searchKey struct {
+	version string
+	query   Query
+}
+
+type searchEntry struct {
+	key     searchKey
+	configs []model.Config
+}
+
+// searchCache is a least recently used cache of search results, safe for
+// concurrent use.
+type searchCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // most recently used first
+	entries map[searchKey]*list.Element
+}
+
+func newSearchCache(size int) *searchCache {
+	return &searchCache{
+		size:    size,
+		order:   list.New(),
+		entries: map[searchKey]*list.Element{},
+	}
+}
+
+// get returns a copy of the cached results for key.
+func (c *searchCache) get(key searchKey) ([]model.Config, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return cloneConfigs(e.Value.(*searchEntry).configs), true
+}
+
+// put caches results for key, evicting the least recently used results
+// when full.
+func (c *searchCache) put(key searchKey, configs []model.Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*searchEntry).configs = cloneConfigs(configs)
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&searchEntry{key: key, configs: cloneConfigs(configs)})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*searchEntry).key)
+	}
+}
+
+// clear drops every cached result, for when the docs change.
+func (c *searchCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	clear(c.entries)
+}
+
+// cloneConfigs copies configs down to their platforms, so callers and the
+// cache can't change each other's results.
+func cloneConfigs(configs []model.Config) []model.Config {
+	clone := slices.Clone(configs)
+	for i := range clone {
+		clone[i].Platforms = slices.Clone(clone[i].Platforms)
+	}
+	return clone
+}
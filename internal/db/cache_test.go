@@ -0,0 +1,46 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+func TestSearchCache(t *testing.T) {
+	c := newSearchCache(2)
+	key := func(text string) searchKey {
+		return searchKey{version: "1.2", query: Query{Text: text}}
+	}
+
+	c.put(key("a"), []model.Config{{Title: "a"}})
+	c.put(key("b"), []model.Config{{Title: "b"}})
+	if _, ok := c.get(key("a")); !ok {
+		t.Fatal("Expected a to be cached")
+	}
+
+	// b is now the least recently used
+	c.put(key("c"), []model.Config{{Title: "c", Platforms: []string{"macos"}}})
+	if _, ok := c.get(key("b")); ok {
+		t.Error("Expected b to be evicted")
+	}
+
+	// Results are copied so callers can't change the cache
+	got, ok := c.get(key("c"))
+	if !ok {
+		t.Fatal("Expected c to be cached")
+	}
+	got[0].Title = "changed"
+	got[0].Platforms[0] = "linux"
+	if got, _ := c.get(key("c")); got[0].Title != "c" || got[0].Platforms[0] != "macos" {
+		t.Errorf("Cached results changed to %+v", got[0])
+	}
+
+	if _, ok := c.get(searchKey{version: "1.1", query: Query{Text: "c"}}); ok {
+		t.Error("Expected results to be cached per version")
+	}
+
+	c.clear()
+	if _, ok := c.get(key("a")); ok {
+		t.Error("Expected the cache to be empty")
+	}
+}
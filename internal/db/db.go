@@ -4,27 +4,43 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
-	"path/filepath"
+	"strings"
 
+	"github.com/intaek-h/ghofig/internal/docparse"
 	"github.com/intaek-h/ghofig/internal/model"
 	_ "modernc.org/sqlite"
 )
 
 var db *sql.DB
 
+// configColumns are the configs columns scanned into a model.Config.
+const configColumns = "id, title, description, category, platforms, since, type, COALESCE(group_id, 0)"
+
+// dbPath is the temp file the database was written to.
+var dbPath string
+
+// version is the Ghostty version whose docs are queried.
+var version string
+
 // Init initializes the database from embedded bytes.
-// It writes the embedded DB to a temp file and opens it.
+// It writes the embedded DB to a temp file of its own and opens it, so
+// docs added at runtime don't affect other running instances.
 func Init(embeddedDB []byte) error {
 	// Write embedded DB to temp file
-	tempDir := os.TempDir()
-	tempFile := filepath.Join(tempDir, "ghofig.db")
-
-	if err := os.WriteFile(tempFile, embeddedDB, 0644); err != nil {
+	tempFile, err := os.CreateTemp("", "ghofig-*.db")
+	if err != nil {
+		return fmt.Errorf("failed to create temp db: %w", err)
+	}
+	dbPath = tempFile.Name()
+	_, err = tempFile.Write(embeddedDB)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
 		return fmt.Errorf("failed to write temp db: %w", err)
 	}
 
-	var err error
-	db, err = sql.Open("sqlite", tempFile+"?mode=ro")
+	db, err = sql.Open("sqlite", dbPath)
 	if err != nil {
 		return fmt.Errorf("failed to open database: %w", err)
 	}
@@ -34,49 +50,341 @@ func Init(embeddedDB []byte) error {
 		return fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	searches.clear()
+
+	// Query the newest docs unless told otherwise
+	err = db.QueryRow("SELECT name FROM versions ORDER BY position DESC LIMIT 1").Scan(&version)
+	if err != nil {
+		return fmt.Errorf("failed to read versions: %w", err)
+	}
+
 	return nil
 }
 
-// Close closes the database connection.
-func Close() error {
-	if db != nil {
-		return db.Close()
+// Versions returns the Ghostty versions with docs in the database, oldest
+// first.
+func Versions() ([]string, error) {
+	rows, err := db.Query("SELECT name FROM versions ORDER BY position")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+// AddDocs stores the docs of another Ghostty version, such as the ones
+// installed with Ghostty, as the newest version. Existing docs for that
+// version are replaced.
+func AddDocs(v string, options []docparse.Option) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM "references" WHERE config_id IN (SELECT id FROM configs WHERE version = ?)`, v); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM similar WHERE config_id IN (SELECT id FROM configs WHERE version = ?)", v); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM configs WHERE version = ?", v); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM versions WHERE name = ?", v); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM groups WHERE version = ?", v); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("INSERT INTO versions (name, position) VALUES (?, (SELECT COALESCE(MAX(position), -1) + 1 FROM versions))", v); err != nil {
+		return err
+	}
+
+	if err := InsertOptions(tx, v, options); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	searches.clear()
+	return nil
+}
+
+// Execer runs statements, as both *sql.DB and *sql.Tx do.
+type Execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Prepare(query string) (*sql.Stmt, error)
+}
+
+// InsertOptions stores the options documented for Ghostty version v, with
+// their groups, references and similar options. The parser builds the
+// embedded database with it, and AddDocs adds docs at runtime.
+func InsertOptions(e Execer, v string, options []docparse.Option) error {
+	stmt, err := e.Prepare("INSERT INTO configs (version, title, description, category, platforms, since, type, group_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	groups := map[string]int64{}
+	ids := map[string]int64{}
+	for _, o := range options {
+		var groupID *int64
+		if o.Group != "" {
+			id, ok := groups[o.Group]
+			if !ok {
+				res, err := e.Exec("INSERT INTO groups (version, name) VALUES (?, ?)", v, o.Group)
+				if err != nil {
+					return err
+				}
+				if id, err = res.LastInsertId(); err != nil {
+					return err
+				}
+				groups[o.Group] = id
+			}
+			groupID = &id
+		}
+
+		res, err := stmt.Exec(v, o.Title, o.Description, o.Category, strings.Join(o.Platforms, ","), o.Since, o.Type, groupID)
+		if err != nil {
+			return err
+		}
+		if ids[o.Title], err = res.LastInsertId(); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range options {
+		for _, target := range o.References {
+			if _, err := e.Exec(`INSERT INTO "references" (config_id, target_id) VALUES (?, ?)`, ids[o.Title], ids[target]); err != nil {
+				return err
+			}
+		}
+		for _, target := range o.Similar {
+			if _, err := e.Exec("INSERT INTO similar (config_id, target_id) VALUES (?, ?)", ids[o.Title], ids[target]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Version returns the Ghostty version whose docs are queried.
+func Version() string {
+	return version
+}
+
+// SetVersion selects the Ghostty version whose docs are queried.
+func SetVersion(v string) error {
+	var exists bool
+	if err := db.QueryRow("SELECT EXISTS(SELECT 1 FROM versions WHERE name = ?)", v).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no docs for Ghostty %s", v)
 	}
+	version = v
 	return nil
 }
 
-// Search searches for configs matching the query.
-// Results prioritize title matches over description matches.
+// Close closes the database connection and removes its temp file.
+func Close() error {
+	if db == nil {
+		return nil
+	}
+	err := db.Close()
+	os.Remove(dbPath)
+	return err
+}
+
+// snippetContext is how many characters of a description are shown before
+// a match, and snippetLength how long a snippet is.
+const (
+	snippetContext = 30
+	snippetLength  = 90
+)
+
+// Search searches for configs matching the query, which can mix text with
+// filters as parsed by ParseQuery.
 func Search(query string) ([]model.Config, error) {
 	if query == "" {
 		return getAllConfigs()
 	}
 
-	likeQuery := "%" + query + "%"
+	q, err := ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return SearchQuery(q)
+}
+
+// synonymTitles selects the options named by synonyms whose phrase is in
+// the :phrases parameter, as prepared by phraseText.
+const synonymTitles = `SELECT title FROM synonyms WHERE instr(:phrases, ' ' || phrase || ' ') > 0`
+
+// phraseText prepares search text for matching synonym phrases: lowercase,
+// with words separated by single spaces and padded so phrases only match
+// whole words.
+func phraseText(text string) string {
+	return " " + strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(text, "-", " "))), " ") + " "
+}
+
+// SearchQuery searches for configs matching a parsed query. The Is filter
+// depends on the user's config and is left to the caller.
+// Text also matches the options of synonyms it contains, so "transparent"
+// finds background-opacity. Results prioritize an exact title, then
+// synonyms, then title matches over description matches. Results that
+// only match in their description carry a snippet of it around the match;
+// ones matched through a synonym alone have none.
+// Queries with filters return every match, others the first 50. Recent
+// results are cached.
+func SearchQuery(q Query) ([]model.Config, error) {
+	key := searchKey{version: version, query: q}
+	if configs, ok := searches.get(key); ok {
+		return configs, nil
+	}
+
+	configs, err := searchQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	searches.put(key, configs)
+	return configs, nil
+}
+
+// searchQuery runs a search against the database.
+func searchQuery(q Query) ([]model.Config, error) {
+	where := []string{"version = :version", "(title LIKE :like OR description LIKE :like OR title IN (" + synonymTitles + "))"}
+	if q.Platform != "" {
+		where = append(where, "(platforms = '' OR ',' || platforms || ',' LIKE :platform)")
+	}
+	if q.Type != "" {
+		where = append(where, "type = :type")
+	}
+	if q.Since != "" {
+		where = append(where, "(since = :since OR since LIKE :since || '.%')")
+	}
+	if q.Category != "" {
+		where = append(where, "lower(category) LIKE :category || '%'")
+	}
+
+	limit := 50
+	if len(q.Filters()) > 0 {
+		limit = -1
+	}
 
 	rows, err := db.Query(`
-		SELECT id, title, description 
-		FROM configs 
-		WHERE title LIKE ? OR description LIKE ?
-		ORDER BY 
-			CASE WHEN title LIKE ? THEN 0 ELSE 1 END,
+		SELECT `+configColumns+`,
+			CASE WHEN title LIKE :like OR pos = 0 THEN '' ELSE
+				CASE WHEN pos > :context + 1 THEN '…' ELSE '' END ||
+				replace(substr(description, max(1, pos - :context), :length), char(10), ' ') ||
+				CASE WHEN pos - :context + :length <= length(description) THEN '…' ELSE '' END
+			END
+		FROM (
+			SELECT *, instr(lower(description), lower(:query)) AS pos
+			FROM configs
+			WHERE `+strings.Join(where, " AND ")+`
+		)
+		ORDER BY
+			CASE
+				WHEN title = lower(:query) THEN 0
+				WHEN title IN (`+synonymTitles+`) THEN 1
+				WHEN title LIKE :like THEN 2
+				ELSE 3
+			END,
 			title
-		LIMIT 50
-	`, likeQuery, likeQuery, likeQuery)
+		LIMIT :limit
+	`,
+		sql.Named("like", "%"+q.Text+"%"),
+		sql.Named("query", q.Text),
+		sql.Named("phrases", phraseText(q.Text)),
+		sql.Named("version", version),
+		sql.Named("platform", "%,"+q.Platform+",%"),
+		sql.Named("type", q.Type),
+		sql.Named("since", q.Since),
+		sql.Named("category", q.Category),
+		sql.Named("context", snippetContext),
+		sql.Named("length", snippetLength),
+		sql.Named("limit", limit),
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	return scanConfigs(rows)
+	var configs []model.Config
+	for rows.Next() {
+		var snippet string
+		c, err := scanConfig(rows, &snippet)
+		if err != nil {
+			return nil, err
+		}
+		c.Snippet = trimSnippet(snippet)
+		configs = append(configs, c)
+	}
+	return configs, rows.Err()
+}
+
+// Synonyms returns the synonyms whose phrase is in text, with the options
+// they stand for that the current version documents.
+func Synonyms(text string) ([]model.Synonym, error) {
+	rows, err := db.Query(`
+		SELECT s.phrase, s.title
+		FROM synonyms s
+		JOIN configs c ON c.title = s.title AND c.version = :version
+		WHERE instr(:phrases, ' ' || s.phrase || ' ') > 0
+		ORDER BY s.phrase, s.rowid
+	`, sql.Named("version", version), sql.Named("phrases", phraseText(text)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var synonyms []model.Synonym
+	for rows.Next() {
+		var phrase, title string
+		if err := rows.Scan(&phrase, &title); err != nil {
+			return nil, err
+		}
+		if n := len(synonyms); n > 0 && synonyms[n-1].Phrase == phrase {
+			synonyms[n-1].Titles = append(synonyms[n-1].Titles, title)
+		} else {
+			synonyms = append(synonyms, model.Synonym{Phrase: phrase, Titles: []string{title}})
+		}
+	}
+	return synonyms, rows.Err()
+}
+
+// trimSnippet drops the partial words a snippet was cut in.
+func trimSnippet(snippet string) string {
+	if rest, ok := strings.CutPrefix(snippet, "…"); ok {
+		if _, after, found := strings.Cut(rest, " "); found {
+			snippet = "…" + after
+		}
+	}
+	if rest, ok := strings.CutSuffix(snippet, "…"); ok {
+		if i := strings.LastIndex(rest, " "); i > 0 {
+			snippet = rest[:i] + "…"
+		}
+	}
+	return snippet
 }
 
 // GetByID retrieves a single config by its ID.
 func GetByID(id int) (*model.Config, error) {
-	row := db.QueryRow("SELECT id, title, description FROM configs WHERE id = ?", id)
+	row := db.QueryRow("SELECT "+configColumns+" FROM configs WHERE id = ?", id)
 
-	var config model.Config
-	err := row.Scan(&config.ID, &config.Title, &config.Description)
+	config, err := scanConfig(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("config not found: %d", id)
@@ -87,9 +395,129 @@ func GetByID(id int) (*model.Config, error) {
 	return &config, nil
 }
 
+// GetByTitle retrieves a single config by its option name.
+func GetByTitle(title string) (*model.Config, error) {
+	row := db.QueryRow("SELECT "+configColumns+" FROM configs WHERE version = ? AND title = ?", version, title)
+
+	config, err := scanConfig(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("config not found: %s", title)
+		}
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Siblings returns the options grouped with c, including c itself, in
+// documentation order. Options outside a group have no siblings.
+func Siblings(c model.Config) ([]model.Config, error) {
+	if c.GroupID == 0 {
+		return nil, nil
+	}
+
+	rows, err := db.Query("SELECT "+configColumns+" FROM configs WHERE group_id = ? ORDER BY id", c.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanConfigs(rows)
+}
+
+// Related returns the options c's description mentions, in the order
+// they're mentioned.
+func Related(c model.Config) ([]model.Config, error) {
+	rows, err := db.Query(`
+		SELECT `+configColumns+`
+		FROM configs
+		JOIN "references" r ON r.target_id = configs.id
+		WHERE r.config_id = ?
+		ORDER BY r.rowid
+	`, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanConfigs(rows)
+}
+
+// ReferencedBy returns the options whose description mentions c, ordered
+// by title.
+func ReferencedBy(c model.Config) ([]model.Config, error) {
+	rows, err := db.Query(`
+		SELECT `+configColumns+`
+		FROM configs
+		JOIN "references" r ON r.config_id = configs.id
+		WHERE r.target_id = ?
+		ORDER BY title
+	`, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanConfigs(rows)
+}
+
+// Similar returns the options whose docs are most like c's, closest
+// first.
+func Similar(c model.Config) ([]model.Config, error) {
+	rows, err := db.Query(`
+		SELECT `+configColumns+`
+		FROM configs
+		JOIN similar s ON s.target_id = configs.id
+		WHERE s.config_id = ?
+		ORDER BY s.rowid
+	`, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanConfigs(rows)
+}
+
 // getAllConfigs returns all configs ordered by title.
 func getAllConfigs() ([]model.Config, error) {
-	rows, err := db.Query("SELECT id, title, description FROM configs ORDER BY title LIMIT 50")
+	rows, err := db.Query("SELECT "+configColumns+" FROM configs WHERE version = ? ORDER BY title LIMIT 50", version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanConfigs(rows)
+}
+
+// GetByCategory returns every config ordered by category, then title.
+// Categories come in their browsing order.
+func GetByCategory() ([]model.Config, error) {
+	rows, err := db.Query(`
+		SELECT `+configColumns+`
+		FROM configs c
+		JOIN categories k ON k.name = c.category
+		WHERE c.version = ?
+		ORDER BY k.position, c.title
+	`, version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanConfigs(rows)
+}
+
+// GetAll returns every config ordered by title.
+func GetAll() ([]model.Config, error) {
+	return getVersion(version)
+}
+
+// getVersion returns every config documented for a version, ordered by
+// title.
+func getVersion(v string) ([]model.Config, error) {
+	rows, err := db.Query("SELECT "+configColumns+" FROM configs WHERE version = ? ORDER BY title", v)
 	if err != nil {
 		return nil, err
 	}
@@ -102,11 +530,121 @@ func getAllConfigs() ([]model.Config, error) {
 func scanConfigs(rows *sql.Rows) ([]model.Config, error) {
 	var configs []model.Config
 	for rows.Next() {
-		var c model.Config
-		if err := rows.Scan(&c.ID, &c.Title, &c.Description); err != nil {
+		c, err := scanConfig(rows)
+		if err != nil {
 			return nil, err
 		}
 		configs = append(configs, c)
 	}
 	return configs, rows.Err()
 }
+
+// scanConfig scans a row of configColumns, followed by any extra columns.
+func scanConfig(row interface{ Scan(...any) error }, extra ...any) (model.Config, error) {
+	var c model.Config
+	var platforms string
+	dest := append([]any{&c.ID, &c.Title, &c.Description, &c.Category, &platforms, &c.Since, &c.Type, &c.GroupID}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return model.Config{}, err
+	}
+	c.Platforms = splitPlatforms(platforms)
+	return c, nil
+}
+
+// splitPlatforms splits the comma separated platforms column.
+func splitPlatforms(platforms string) []string {
+	if platforms == "" {
+		return nil
+	}
+	return strings.Split(platforms, ",")
+}
+
+// SearchActions searches for keybind actions matching the query.
+// Results prioritize name matches over description matches.
+func SearchActions(query string) ([]model.Action, error) {
+	if query == "" {
+		return GetActions()
+	}
+
+	likeQuery := "%" + query + "%"
+
+	rows, err := db.Query(`
+		SELECT id, name, parameters, description
+		FROM actions
+		WHERE name LIKE ? OR description LIKE ?
+		ORDER BY
+			CASE WHEN name LIKE ? THEN 0 ELSE 1 END,
+			name
+		LIMIT 50
+	`, likeQuery, likeQuery, likeQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanActions(rows)
+}
+
+// GetActions returns every keybind action ordered by name.
+func GetActions() ([]model.Action, error) {
+	rows, err := db.Query("SELECT id, name, parameters, description FROM actions ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanActions(rows)
+}
+
+// scanActions scans rows into a slice of Action.
+func scanActions(rows *sql.Rows) ([]model.Action, error) {
+	var actions []model.Action
+	for rows.Next() {
+		var a model.Action
+		if err := rows.Scan(&a.ID, &a.Name, &a.Parameters, &a.Description); err != nil {
+			return nil, err
+		}
+		actions = append(actions, a)
+	}
+	return actions, rows.Err()
+}
+
+// Changes compares the docs of two Ghostty versions and returns the options
+// added, removed, and with a changed description, in that order.
+func Changes(from, to string) ([]model.Change, error) {
+	oldConfigs, err := getVersion(from)
+	if err != nil {
+		return nil, err
+	}
+	newConfigs, err := getVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	old := make(map[string]*model.Config, len(oldConfigs))
+	for i := range oldConfigs {
+		old[oldConfigs[i].Title] = &oldConfigs[i]
+	}
+	current := make(map[string]bool, len(newConfigs))
+
+	var added, removed, changed []model.Change
+	for i := range newConfigs {
+		c := &newConfigs[i]
+		current[c.Title] = true
+
+		prev, ok := old[c.Title]
+		switch {
+		case !ok:
+			added = append(added, model.Change{Kind: model.ChangeAdded, New: c})
+		case prev.Description != c.Description:
+			changed = append(changed, model.Change{Kind: model.ChangeDescription, Old: prev, New: c})
+		}
+	}
+	for i := range oldConfigs {
+		if !current[oldConfigs[i].Title] {
+			removed = append(removed, model.Change{Kind: model.ChangeRemoved, Old: &oldConfigs[i]})
+		}
+	}
+
+	return append(append(added, removed...), changed...), nil
+}
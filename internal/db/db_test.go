@@ -2,7 +2,11 @@ package db
 
 import (
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/intaek-h/ghofig/internal/docparse"
+	"github.com/intaek-h/ghofig/internal/model"
 )
 
 func TestSearch(t *testing.T) {
@@ -50,3 +54,342 @@ func TestSearch(t *testing.T) {
 		t.Errorf("GetByID returned wrong config: got %s, want %s", config.Title, results[0].Title)
 	}
 }
+
+func TestSearchActions(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	results, err := SearchActions("split")
+	if err != nil {
+		t.Fatalf("SearchActions failed: %v", err)
+	}
+
+	found := false
+	for _, a := range results {
+		if a.Name == "new_split" {
+			found = true
+			if a.Parameters == "" {
+				t.Error("Expected new_split to have parameters")
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected new_split in results")
+	}
+}
+
+func TestGetByCategory(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	configs, err := GetByCategory()
+	if err != nil {
+		t.Fatalf("GetByCategory failed: %v", err)
+	}
+	if len(configs) == 0 {
+		t.Fatal("Expected configs")
+	}
+
+	categories := map[string]string{}
+	for _, c := range configs {
+		categories[c.Title] = c.Category
+	}
+	want := map[string]string{
+		"font-family":              "Fonts",
+		"macos-titlebar-style":     "macOS",
+		"gtk-quick-terminal-layer": "Quick Terminal",
+		"cursor-style":             "Cursor",
+	}
+	for title, category := range want {
+		if categories[title] != category {
+			t.Errorf("%s: got category %q, want %q", title, categories[title], category)
+		}
+	}
+
+	for _, c := range configs {
+		switch c.Title {
+		case "macos-titlebar-style":
+			if !c.AppliesTo("macos") || c.AppliesTo("linux") {
+				t.Errorf("macos-titlebar-style: got platforms %v, want [macos]", c.Platforms)
+			}
+		case "font-size":
+			if len(c.Platforms) != 0 {
+				t.Errorf("font-size: got platforms %v, want none", c.Platforms)
+			}
+		}
+	}
+}
+
+func TestChanges(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	// Only one version is embedded, so compare it to made-up newer docs
+	from := Version()
+	err = AddDocs("9.9", docparse.Options([]docparse.Entry{
+		{Title: "font-size", Description: "Font size in points, now with a changed description."},
+		{Title: "brand-new-option", Description: "An option the embedded docs don't have."},
+	}))
+	if err != nil {
+		t.Fatalf("AddDocs failed: %v", err)
+	}
+
+	versions, err := Versions()
+	if err != nil {
+		t.Fatalf("Versions failed: %v", err)
+	}
+	if len(versions) < 2 || versions[len(versions)-1] != "9.9" {
+		t.Fatalf("Expected the added docs to be the newest version, got %v", versions)
+	}
+
+	changes, err := Changes(from, "9.9")
+	if err != nil {
+		t.Fatalf("Changes failed: %v", err)
+	}
+
+	kinds := map[string]model.ChangeKind{}
+	for _, c := range changes {
+		kinds[c.Title()] = c.Kind
+	}
+	want := map[string]model.ChangeKind{
+		"brand-new-option": model.ChangeAdded,
+		"background-image": model.ChangeRemoved,
+		"font-size":        model.ChangeDescription,
+	}
+	for title, kind := range want {
+		if got, ok := kinds[title]; !ok || got != kind {
+			t.Errorf("%s: got %v (found %v), want %s", title, got, ok, kind)
+		}
+	}
+
+	if err := SetVersion("9.9"); err != nil {
+		t.Fatalf("SetVersion failed: %v", err)
+	}
+	if _, err := GetByTitle("background-image"); err == nil {
+		t.Error("Expected background-image to be missing from the added docs")
+	}
+	if err := SetVersion("0.1"); err == nil {
+		t.Error("Expected an error for a version without docs")
+	}
+}
+
+func TestSiblings(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	config, err := GetByTitle("font-family-bold")
+	if err != nil {
+		t.Fatalf("GetByTitle failed: %v", err)
+	}
+	siblings, err := Siblings(*config)
+	if err != nil {
+		t.Fatalf("Siblings failed: %v", err)
+	}
+
+	var titles []string
+	for _, s := range siblings {
+		titles = append(titles, s.Title)
+	}
+	want := []string{"font-family", "font-family-bold", "font-family-italic", "font-family-bold-italic"}
+	if len(titles) != len(want) {
+		t.Fatalf("siblings = %v, want %v", titles, want)
+	}
+	for i := range want {
+		if titles[i] != want[i] {
+			t.Errorf("siblings = %v, want %v", titles, want)
+			break
+		}
+	}
+
+	config, err = GetByTitle("font-size")
+	if err != nil {
+		t.Fatalf("GetByTitle failed: %v", err)
+	}
+	if siblings, _ := Siblings(*config); len(siblings) != 0 {
+		t.Errorf("font-size should have no siblings, got %d", len(siblings))
+	}
+}
+
+func TestReferences(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	config, err := GetByTitle("background-blur")
+	if err != nil {
+		t.Fatalf("GetByTitle failed: %v", err)
+	}
+	related, err := Related(*config)
+	if err != nil {
+		t.Fatalf("Related failed: %v", err)
+	}
+	if len(related) == 0 {
+		t.Fatal("Expected background-blur to mention other options")
+	}
+
+	// Every option background-blur mentions is referenced by it
+	for _, r := range related {
+		referrers, err := ReferencedBy(r)
+		if err != nil {
+			t.Fatalf("ReferencedBy failed: %v", err)
+		}
+		found := false
+		for _, c := range referrers {
+			if c.ID == config.ID {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s should be referenced by background-blur", r.Title)
+		}
+	}
+}
+
+func TestSearchSnippets(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	results, err := Search("transparency")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatal("Expected results for 'transparency' query")
+	}
+
+	for _, r := range results {
+		if !strings.Contains(strings.ToLower(r.Snippet), "transparency") {
+			t.Errorf("%s snippet %q should contain the match", r.Title, r.Snippet)
+		}
+		if strings.Contains(r.Snippet, "\n") {
+			t.Errorf("%s snippet %q should be one line", r.Title, r.Snippet)
+		}
+	}
+
+	// Title matches need no snippet
+	results, err = Search("font-size")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) == 0 || results[0].Title != "font-size" || results[0].Snippet != "" {
+		t.Errorf("Expected font-size first without a snippet, got %+v", results[0])
+	}
+}
+
+func TestSynonyms(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	results, err := Search("transparent")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) < 2 || results[0].Title != "background-blur" || results[1].Title != "background-opacity" {
+		t.Errorf("Expected the options for transparent first, got %v", titles(results))
+	}
+
+	synonyms, err := Synonyms("Hide  Titlebar")
+	if err != nil {
+		t.Fatalf("Synonyms failed: %v", err)
+	}
+	if len(synonyms) != 1 || synonyms[0].Phrase != "hide titlebar" || synonyms[0].Titles[0] != "macos-titlebar-style" {
+		t.Errorf("Unexpected synonyms: %+v", synonyms)
+	}
+
+	// Phrases only match whole words
+	if synonyms, _ := Synonyms("dimensions"); len(synonyms) != 0 {
+		t.Errorf("Expected no synonyms, got %+v", synonyms)
+	}
+
+	// An exact title stays ahead of synonyms
+	results, err = Search("cursor-opacity")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) == 0 || results[0].Title != "cursor-opacity" {
+		t.Errorf("Expected cursor-opacity first, got %v", titles(results))
+	}
+}
+
+func TestSimilar(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	c, err := GetByTitle("background-opacity")
+	if err != nil {
+		t.Fatalf("GetByTitle failed: %v", err)
+	}
+	similar, err := Similar(*c)
+	if err != nil {
+		t.Fatalf("Similar failed: %v", err)
+	}
+	got := strings.Join(titles(similar), ",")
+	for _, want := range []string{"background-opacity-cells", "unfocused-split-opacity"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %s among the options similar to background-opacity, got %s", want, got)
+		}
+	}
+}
+
+func titles(configs []model.Config) []string {
+	var t []string
+	for _, c := range configs {
+		t = append(t, c.Title)
+	}
+	return t
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/docparse"
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+// Query is a search query: free text mixed with key:value filters, like
+// "cursor platform:linux type:bool since:1.2 is:set category:window".
+type Query struct {
+	Text     string // free text matched against titles and descriptions
+	Platform string // only options that work on this platform
+	Type     string // only options taking this kind of value
+	Since    string // only options added in this version, like "1.2"
+	Category string // only options in categories starting with this
+	Is       string // "set" or "unset" in the user's config, applied by callers
+}
+
+// queryKeys are the filters a query accepts, in the order they're listed.
+var queryKeys = []string{"platform", "type", "since", "category", "is"}
+
+// ParseQuery splits a query into free text and filters. Words with a colon
+// are filters; unknown keys and invalid values are errors.
+func ParseQuery(s string) (Query, error) {
+	var q Query
+	var text []string
+
+	for _, word := range strings.Fields(s) {
+		key, value, ok := strings.Cut(word, ":")
+		if !ok || key == "" {
+			text = append(text, word)
+			continue
+		}
+		key = strings.ToLower(key)
+		value = strings.ToLower(value)
+		if strings.Trim(key, "abcdefghijklmnopqrstuvwxyz") != "" {
+			// Not a filter key, like in "ctrl+a:"
+			text = append(text, word)
+			continue
+		}
+		if !slices.Contains(queryKeys, key) {
+			return Query{}, fmt.Errorf("unknown filter %q, use %s", key+":", strings.Join(queryKeys, ", "))
+		}
+		if value == "" {
+			return Query{}, fmt.Errorf("%s: needs a value", key)
+		}
+
+		switch key {
+		case "platform":
+			switch value {
+			case "macos", "mac", "darwin":
+				q.Platform = model.PlatformMacOS
+			case "linux", "gtk":
+				q.Platform = model.PlatformLinux
+			default:
+				return Query{}, fmt.Errorf("unknown platform %q, use macos or linux", value)
+			}
+		case "type":
+			if !slices.Contains(docparse.Types, value) {
+				return Query{}, fmt.Errorf("unknown type %q, use %s", value, strings.Join(docparse.Types, ", "))
+			}
+			q.Type = value
+		case "since":
+			if strings.Trim(value, "0123456789.") != "" {
+				return Query{}, fmt.Errorf("since: wants a version like 1.2, got %q", value)
+			}
+			q.Since = value
+		case "category":
+			q.Category = strings.ReplaceAll(value, "-", " ")
+		case "is":
+			if value != "set" && value != "unset" {
+				return Query{}, fmt.Errorf("is: wants set or unset, got %q", value)
+			}
+			q.Is = value
+		}
+	}
+
+	q.Text = strings.Join(text, " ")
+	return q, nil
+}
+
+// Filters returns the query's filters as "key:value" strings.
+func (q Query) Filters() []string {
+	var filters []string
+	add := func(key, value string) {
+		if value != "" {
+			filters = append(filters, key+":"+strings.ReplaceAll(value, " ", "-"))
+		}
+	}
+	add("platform", q.Platform)
+	add("type", q.Type)
+	add("since", q.Since)
+	add("category", q.Category)
+	add("is", q.Is)
+	return filters
+}
+
+// IsEmpty reports whether the query has neither text nor filters.
+func (q Query) IsEmpty() bool {
+	return q.Text == "" && len(q.Filters()) == 0
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+func TestParseQuery(t *testing.T) {
+	q, err := ParseQuery("cursor platform:Linux type:bool since:1.2 is:set category:quick-terminal ctrl+a:")
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	want := Query{
+		Text:     "cursor ctrl+a:",
+		Platform: model.PlatformLinux,
+		Type:     "bool",
+		Since:    "1.2",
+		Category: "quick terminal",
+		Is:       "set",
+	}
+	if q != want {
+		t.Errorf("ParseQuery = %+v, want %+v", q, want)
+	}
+	if got := strings.Join(q.Filters(), " "); got != "platform:linux type:bool since:1.2 category:quick-terminal is:set" {
+		t.Errorf("Filters = %q", got)
+	}
+
+	for _, bad := range []string{"colour:red", "type:", "type:boolean", "platform:windows", "since:new", "is:maybe"} {
+		if _, err := ParseQuery(bad); err == nil {
+			t.Errorf("ParseQuery(%q) should fail", bad)
+		}
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	embeddedDB, err := os.ReadFile("../../data/ghofig.db")
+	if err != nil {
+		t.Fatalf("Failed to read test db: %v", err)
+	}
+
+	if err := Init(embeddedDB); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+	defer Close()
+
+	results, err := Search("platform:linux type:bool category:window")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatal("Expected results")
+	}
+	for _, r := range results {
+		if !r.AppliesTo(model.PlatformLinux) || r.Type != "bool" || r.Category != "Window" {
+			t.Errorf("%s doesn't match the filters: %+v", r.Title, r)
+		}
+	}
+
+	results, err = Search("since:1.2")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatal("Expected options added in 1.2")
+	}
+	for _, r := range results {
+		if !strings.HasPrefix(r.Since, "1.2.") {
+			t.Errorf("%s was added in %q, not 1.2", r.Title, r.Since)
+		}
+	}
+
+	// Filters applied by callers, like is:, lift the limit too
+	limited, err := SearchQuery(Query{Text: "e"})
+	if err != nil {
+		t.Fatalf("SearchQuery failed: %v", err)
+	}
+	all, err := SearchQuery(Query{Text: "e", Is: "set"})
+	if err != nil {
+		t.Fatalf("SearchQuery failed: %v", err)
+	}
+	if len(limited) != 50 || len(all) <= 50 {
+		t.Errorf("Expected 50 results without filters and more with is:, got %d and %d", len(limited), len(all))
+	}
+
+	if _, err := Search("type:nope"); err == nil {
+		t.Error("Expected an error for an invalid filter")
+	}
+}
@@ -0,0 +1,124 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op is the kind of change a diff line represents.
+type Op int
+
+const (
+	Equal Op = iota
+	Insert
+	Delete
+)
+
+// Line is a single line of a diff.
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// String returns the line with a "+", "-" or " " marker.
+func (l Line) String() string {
+	switch l.Op {
+	case Insert:
+		return "+" + l.Text
+	case Delete:
+		return "-" + l.Text
+	default:
+		return " " + l.Text
+	}
+}
+
+// Lines computes a line-by-line diff between old and new text using the
+// longest common subsequence. Config files are small, so the quadratic
+// table is fine.
+func Lines(oldText, newText string) []Line {
+	a := splitLines(oldText)
+	b := splitLines(newText)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []Line
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Op: Equal, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Op: Delete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Op: Insert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Op: Delete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Op: Insert, Text: b[j]})
+	}
+
+	return lines
+}
+
+// Unified renders a diff with the given number of context lines around
+// each change. Returns an empty string if there are no changes.
+func Unified(lines []Line, context int) string {
+	// Mark which lines are close enough to a change to be shown
+	show := make([]bool, len(lines))
+	changed := false
+	for i, l := range lines {
+		if l.Op == Equal {
+			continue
+		}
+		changed = true
+		for j := max(0, i-context); j <= min(len(lines)-1, i+context); j++ {
+			show[j] = true
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var b strings.Builder
+	skipping := false
+	for i, l := range lines {
+		if !show[i] {
+			skipping = true
+			continue
+		}
+		if skipping {
+			b.WriteString("@@ ... @@\n")
+			skipping = false
+		}
+		fmt.Fprintln(&b, l.String())
+	}
+	return b.String()
+}
+
+// splitLines splits text into lines, ignoring a single trailing newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
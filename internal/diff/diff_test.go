@@ -0,0 +1,46 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{"insertion", "a\nc\n", "a\nb\nc\n", []string{" a", "+b", " c"}},
+		{"deletion", "a\nb\nc\n", "a\nc\n", []string{" a", "-b", " c"}},
+		{"replacement", "a\nb\nc\n", "a\nB\nc\n", []string{" a", "-b", "+B", " c"}},
+		{"append at end", "a", "a\nb\n", []string{" a", "+b"}},
+		{"empty old", "", "a\nb\n", []string{"+a", "+b"}},
+		{"empty new", "a\nb\n", "", []string{"-a", "-b"}},
+		{"both empty", "", "", nil},
+		{"unchanged", "a\nb\n", "a\nb", []string{" a", " b"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, l := range Lines(tt.old, tt.new) {
+			got = append(got, l.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Lines = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUnified(t *testing.T) {
+	old := "1\n2\n3\n4\n5\n6\n7\n8\n"
+	new := "1\n2\n3\n4\n5\n6\n7\nx\n"
+
+	want := " 6\n 7\n-8\n+x\n"
+	if got := Unified(Lines(old, new), 2); got != "@@ ... @@\n"+want {
+		t.Errorf("Unified =\n%s\nwant\n@@ ... @@\n%s", got, want)
+	}
+
+	if got := Unified(Lines(old, old), 2); got != "" {
+		t.Errorf("Unified of no changes = %q, want empty", got)
+	}
+}
@@ -0,0 +1,58 @@
+package docparse
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Action is a documented keybind action.
+type Action struct {
+	Name        string
+	Parameters  string // parameters the action accepts, comma separated
+	Description string
+}
+
+// backtickPattern matches inline code like `value`
+var backtickPattern = regexp.MustCompile("`([^`]+)`")
+
+// Actions converts parsed keybind action docs into actions, extracting the
+// parameters each action accepts from its description.
+func Actions(entries []Entry) []Action {
+	actions := make([]Action, 0, len(entries))
+	for _, e := range entries {
+		actions = append(actions, Action{
+			Name:        e.Title,
+			Parameters:  parseParameters(e.Description),
+			Description: e.Description,
+		})
+	}
+	return actions
+}
+
+// parseParameters extracts an action's parameters from its description.
+// Free-form parameters are documented as "Argument: `<name>`", and
+// enumerated ones as a "Valid arguments:" list of backticked values.
+// Returns an empty string for actions that take no parameter.
+func parseParameters(description string) string {
+	var params []string
+	inList := false
+
+	for _, line := range strings.Split(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if rest, ok := strings.CutPrefix(trimmed, "Argument:"); ok {
+			return strings.Trim(strings.TrimSpace(rest), "`")
+		}
+		if trimmed == "Valid arguments:" {
+			inList = true
+			continue
+		}
+		if inList && strings.HasPrefix(trimmed, "- ") {
+			for _, match := range backtickPattern.FindAllStringSubmatch(trimmed, -1) {
+				params = append(params, match[1])
+			}
+		}
+	}
+
+	return strings.Join(params, ", ")
+}
@@ -0,0 +1,122 @@
+package docparse
+
+import "strings"
+
+// Categories lists every category in the order they're browsed.
+var Categories = []string{
+	"Fonts",
+	"Colors",
+	"Background",
+	"Cursor",
+	"Mouse",
+	"Clipboard & Selection",
+	"Window",
+	"Quick Terminal",
+	"Keybinds",
+	"Command & Shell",
+	"Shell Integration",
+	"Terminal",
+	"Shaders",
+	"Notifications",
+	"Application",
+	"macOS",
+	"GTK",
+	"Linux cgroup",
+	"Other",
+}
+
+// categoryOverrides assigns options that the prefix rules get wrong or
+// don't cover.
+var categoryOverrides = map[string]string{
+	"theme":                         "Colors",
+	"background":                    "Colors",
+	"foreground":                    "Colors",
+	"palette":                       "Colors",
+	"minimum-contrast":              "Colors",
+	"bold-color":                    "Colors",
+	"faint-opacity":                 "Colors",
+	"alpha-blending":                "Colors",
+	"selection-foreground":          "Colors",
+	"selection-background":          "Colors",
+	"cursor-click-to-move":          "Mouse",
+	"focus-follows-mouse":           "Mouse",
+	"click-repeat-interval":         "Mouse",
+	"right-click-action":            "Mouse",
+	"copy-on-select":                "Clipboard & Selection",
+	"grapheme-width-method":         "Fonts",
+	"freetype-load-flags":           "Fonts",
+	"maximize":                      "Window",
+	"fullscreen":                    "Window",
+	"title":                         "Window",
+	"class":                         "Window",
+	"x11-instance-name":             "Window",
+	"split-divider-color":           "Window",
+	"initial-window":                "Window",
+	"keybind":                       "Keybinds",
+	"command-palette-entry":         "Keybinds",
+	"command":                       "Command & Shell",
+	"initial-command":               "Command & Shell",
+	"env":                           "Command & Shell",
+	"input":                         "Command & Shell",
+	"wait-after-command":            "Command & Shell",
+	"abnormal-command-exit-runtime": "Command & Shell",
+	"working-directory":             "Command & Shell",
+	"scrollback-limit":              "Terminal",
+	"scroll-to-bottom":              "Terminal",
+	"title-report":                  "Terminal",
+	"image-storage-limit":           "Terminal",
+	"osc-color-report-format":       "Terminal",
+	"vt-kam-allowed":                "Terminal",
+	"term":                          "Terminal",
+	"enquiry-response":              "Terminal",
+	"app-notifications":             "Notifications",
+	"desktop-notifications":         "Notifications",
+	"confirm-close-surface":         "Application",
+	"undo-timeout":                  "Application",
+	"async-backend":                 "Application",
+	"gtk-quick-terminal-layer":      "Quick Terminal",
+	"gtk-quick-terminal-namespace":  "Quick Terminal",
+}
+
+// categoryRules assigns categories by option name prefix. The first
+// matching rule wins, so more specific prefixes come first.
+var categoryRules = []struct {
+	prefix   string
+	category string
+}{
+	{"adjust-cursor-", "Cursor"},
+	{"adjust-", "Fonts"},
+	{"font-", "Fonts"},
+	{"background-", "Background"},
+	{"cursor-", "Cursor"},
+	{"mouse-", "Mouse"},
+	{"clipboard-", "Clipboard & Selection"},
+	{"selection-", "Clipboard & Selection"},
+	{"window-", "Window"},
+	{"unfocused-split-", "Window"},
+	{"resize-overlay", "Window"},
+	{"quick-terminal-", "Quick Terminal"},
+	{"shell-integration", "Shell Integration"},
+	{"link", "Terminal"},
+	{"custom-shader", "Shaders"},
+	{"bell-", "Notifications"},
+	{"quit-after-", "Application"},
+	{"config-", "Application"},
+	{"auto-update", "Application"},
+	{"macos-", "macOS"},
+	{"gtk-", "GTK"},
+	{"linux-cgroup", "Linux cgroup"},
+}
+
+// categoryOf returns the category an option is browsed under.
+func categoryOf(title string) string {
+	if category, ok := categoryOverrides[title]; ok {
+		return category
+	}
+	for _, rule := range categoryRules {
+		if strings.HasPrefix(title, rule.prefix) {
+			return rule.category
+		}
+	}
+	return "Other"
+}
@@ -0,0 +1,159 @@
+// Package docparse reads Ghostty's option documentation: the reference
+// pages the embedded database is built from, and the docs that ship with
+// an installed Ghostty.
+package docparse
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Entry is one documented name and its description.
+type Entry struct {
+	Title       string
+	Description string
+	Group       string // first title of the block, when several titles share it
+}
+
+// Option is a documented config option with the details derived from its
+// description.
+type Option struct {
+	Title       string
+	Description string
+	Category    string
+	Platforms   []string // platforms the option is limited to, empty for all
+	Since       string   // version the option was added in, if documented
+	Group       string   // first option of the group sharing this description
+	References  []string // other options the description mentions
+	Type        string   // kind of value, one of Types
+	Similar     []string // options with the most similar docs, closest first
+}
+
+// h2Pattern matches lines like: ## `config-name`
+var h2Pattern = regexp.MustCompile("^## `(.+)`$")
+
+// sincePattern matches an option's "Available since: 1.2.0" line. Indented
+// or parenthesized notes about individual values don't count.
+var sincePattern = regexp.MustCompile(`(?m)^Available since: (\d+\.\d+\.\d+)`)
+
+// Parse reads a reference page where each entry starts with a
+// "## `name`" header. Consecutive headers share the description that
+// follows them, as the font-family variants do. Headers inside code fences
+// are part of the description, and a trailing header without a description
+// is dropped.
+func Parse(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	var pendingTitles []string
+	var descriptionLines []string
+	inDescription := false
+	inFence := false
+
+	flush := func() {
+		description := strings.TrimSpace(strings.Join(descriptionLines, "\n"))
+		group := ""
+		if len(pendingTitles) > 1 {
+			group = pendingTitles[0]
+		}
+		for _, title := range pendingTitles {
+			entries = append(entries, Entry{Title: title, Description: description, Group: group})
+		}
+		pendingTitles = nil
+		descriptionLines = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+		}
+
+		// Check if line is an h2 header
+		if match := h2Pattern.FindStringSubmatch(line); match != nil && !inFence {
+			// If we were building a description, flush it
+			if inDescription && len(pendingTitles) > 0 {
+				flush()
+			}
+
+			// Add this title to pending
+			pendingTitles = append(pendingTitles, match[1])
+			inDescription = false
+			continue
+		}
+
+		// If we have pending titles and hit non-empty content, start description
+		if len(pendingTitles) > 0 {
+			// Skip empty lines between h2 and description start
+			if !inDescription && strings.TrimSpace(line) == "" {
+				continue
+			}
+			inDescription = true
+			descriptionLines = append(descriptionLines, line)
+		}
+	}
+
+	// Flush any remaining entry
+	if len(pendingTitles) > 0 && len(descriptionLines) > 0 {
+		flush()
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Options derives the category, platforms, first version and references
+// of each documented option.
+func Options(entries []Entry) []Option {
+	titles := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		titles[e.Title] = true
+	}
+
+	options := make([]Option, 0, len(entries))
+	for _, e := range entries {
+		options = append(options, Option{
+			Title:       e.Title,
+			Description: e.Description,
+			Category:    categoryOf(e.Title),
+			Platforms:   platformsOf(e.Title, e.Description),
+			Since:       availableSince(e.Description),
+			Group:       e.Group,
+			References:  referencesOf(e.Title, e.Description, titles),
+			Type:        typeOf(e.Title, e.Description),
+		})
+	}
+	similarOptions(options)
+	return options
+}
+
+// referencesOf returns the options a description mentions in backticks,
+// either by name or as a "name = value" example, in the order they're
+// first mentioned.
+func referencesOf(title, description string, titles map[string]bool) []string {
+	var refs []string
+	seen := map[string]bool{title: true}
+	for _, match := range backtickPattern.FindAllStringSubmatch(description, -1) {
+		name, _, _ := strings.Cut(match[1], "=")
+		name = strings.TrimPrefix(strings.TrimSpace(name), "--")
+		if titles[name] && !seen[name] {
+			seen[name] = true
+			refs = append(refs, name)
+		}
+	}
+	return refs
+}
+
+// availableSince returns the version an option was added in, or "" if the
+// docs don't say.
+func availableSince(description string) string {
+	if match := sincePattern.FindStringSubmatch(description); match != nil {
+		return match[1]
+	}
+	return ""
+}
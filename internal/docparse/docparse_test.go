@@ -0,0 +1,161 @@
+package docparse
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func TestParseGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.mdx.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".mdx.txt")
+		t.Run(name, func(t *testing.T) {
+			file, err := os.Open(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			entries, err := Parse(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var b strings.Builder
+			for _, e := range entries {
+				fmt.Fprintf(&b, "## %s", e.Title)
+				if e.Group != "" {
+					fmt.Fprintf(&b, " (group %s)", e.Group)
+				}
+				fmt.Fprintf(&b, "\n%s\n\n", e.Description)
+			}
+			got := b.String()
+
+			golden := filepath.Join("testdata", name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("entries differ from %s:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestActions(t *testing.T) {
+	entries := []Entry{
+		{Title: "ignore", Description: "Ignore this key combination."},
+		{Title: "goto_split", Description: "Focus a split.\n\nValid arguments:\n\n- `previous`, `next`\n- `up`"},
+		{Title: "csi", Description: "Send a CSI sequence.\n\nArgument: `<text>`"},
+	}
+
+	want := []string{"", "previous, next, up", "<text>"}
+	for i, a := range Actions(entries) {
+		if a.Parameters != want[i] {
+			t.Errorf("%s parameters = %q, want %q", a.Name, a.Parameters, want[i])
+		}
+	}
+}
+
+func TestOptionsReferences(t *testing.T) {
+	entries := []Entry{
+		{Title: "font-family", Description: "See `font-style` and `font-size`. Set `font-family` again for fallbacks."},
+		{Title: "font-style", Description: "Overrides `font-family`, not `font-style = bold` or `unknown`."},
+		{Title: "font-size", Description: "Font size in points, like `--font-size=12`."},
+		{Title: "font-thicken", Description: "Try `font-size = 14` instead."},
+	}
+
+	want := map[string]string{
+		"font-family":  "font-style,font-size",
+		"font-style":   "font-family",
+		"font-size":    "",
+		"font-thicken": "font-size",
+	}
+	for _, o := range Options(entries) {
+		if got := strings.Join(o.References, ","); got != want[o.Title] {
+			t.Errorf("%s references = %q, want %q", o.Title, got, want[o.Title])
+		}
+	}
+}
+
+func TestOptionsSimilar(t *testing.T) {
+	entries := []Entry{
+		{Title: "background-opacity", Description: "The opacity level of the background, from 0 to 1."},
+		{Title: "unfocused-split-opacity", Description: "The opacity level of unfocused splits, from 0.15 to 1."},
+		{Title: "font-family", Description: "The font families to use.", Group: "font-family"},
+		{Title: "font-family-bold", Description: "The font families to use.", Group: "font-family"},
+		{Title: "keybind", Description: "Trigger actions with key sequences."},
+	}
+
+	want := map[string]string{
+		"background-opacity":      "unfocused-split-opacity",
+		"unfocused-split-opacity": "background-opacity",
+		"font-family":             "",
+		"font-family-bold":        "",
+		"keybind":                 "",
+	}
+	for _, o := range Options(entries) {
+		if got := strings.Join(o.Similar, ","); got != want[o.Title] {
+			t.Errorf("%s similar = %q, want %q", o.Title, got, want[o.Title])
+		}
+	}
+}
+
+func TestOptionsSimilarReproducible(t *testing.T) {
+	f, err := os.Open("../../reference.mdx.txt")
+	if err != nil {
+		t.Fatalf("open reference: %v", err)
+	}
+	defer f.Close()
+	entries, err := Parse(f)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	first := Options(entries)
+	for run := 0; run < 3; run++ {
+		for i, o := range Options(entries) {
+			if !slices.Equal(o.Similar, first[i].Similar) {
+				t.Fatalf("%s similar = %v, then %v", o.Title, first[i].Similar, o.Similar)
+			}
+		}
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	tests := []struct {
+		title, description, want string
+	}{
+		{"background", "Background color for the window. Specified as either hex (`#RRGGBB` or `RRGGBB`) or a named X11 color.", "color"},
+		{"cursor-style", "The style of the cursor.\n\nValid values are:\n\n  * `block`\n  * `bar`", "enum"},
+		{"copy-on-select", "Whether to automatically copy selected text to the clipboard.", "bool"},
+		{"window-vsync", "Synchronize rendering with the screen refresh rate. If true, this will minimize tearing.", "bool"},
+		{"font-size", "Font size in points. This value can be a non-integer.", "number"},
+		{"background-opacity", "The opacity level (opposite of transparency) of the background.", "number"},
+		{"keybind", "Key bindings.\n\nThe format is `trigger=action`.\n\nFor `global:` keybinds, if this is true, they work everywhere.", "string"},
+		{"title", "The title Ghostty will use for the window.", "string"},
+	}
+
+	for _, tt := range tests {
+		if got := typeOf(tt.title, tt.description); got != tt.want {
+			t.Errorf("typeOf(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package docparse
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// manTitlePattern matches an option title in ghostty.5.md, like
+// "`font-family`" or "**`font-family`**".
+var manTitlePattern = regexp.MustCompile("^(?:\\*\\*)?`(?:--)?([a-z0-9][a-z0-9-]*)`(?:\\*\\*)?$")
+
+// ParseManPage reads the options section of the ghostty.5.md man page
+// installed with Ghostty. Each option is a title line followed by a
+// definition list item (":   " then lines indented by four spaces).
+// Options without a description share the one before, the way grouped
+// options like font-family-bold are documented.
+func ParseManPage(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	var title string
+	var lines []string
+	inOptions := true
+
+	flush := func() {
+		if title == "" {
+			return
+		}
+		description := strings.TrimSpace(strings.Join(lines, "\n"))
+		entries = appendEntry(entries, title, description)
+		title = ""
+		lines = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// Top level sections: options come before the keybind actions
+		if strings.HasPrefix(line, "# ") {
+			flush()
+			inOptions = !strings.Contains(strings.ToUpper(line), "ACTION")
+			continue
+		}
+		if !inOptions {
+			continue
+		}
+
+		if match := manTitlePattern.FindStringSubmatch(line); match != nil {
+			flush()
+			title = match[1]
+			continue
+		}
+		if title == "" {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, ":   "):
+			lines = append(lines, line[4:])
+		case strings.HasPrefix(line, "    "):
+			lines = append(lines, line[4:])
+		case strings.TrimSpace(line) == "":
+			lines = append(lines, "")
+		}
+	}
+	flush()
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ParseShowConfig reads the output of `ghostty +show-config --default
+// --docs`: each option's docs as "#" comments, then its default value
+// lines. Options without comments share the docs of the option before.
+func ParseShowConfig(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	var comments []string
+	seen := map[string]bool{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "#" || strings.HasPrefix(line, "# ") {
+			comments = append(comments, strings.TrimPrefix(strings.TrimPrefix(line, "#"), " "))
+			continue
+		}
+
+		key, _, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+
+		// Repeatable options print one line per default value
+		if seen[key] {
+			comments = nil
+			continue
+		}
+		seen[key] = true
+
+		description := strings.TrimSpace(strings.Join(comments, "\n"))
+		entries = appendEntry(entries, key, description)
+		comments = nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// appendEntry adds an option to entries. An option without a description
+// joins the group of the option before and shares its description.
+func appendEntry(entries []Entry, title, description string) []Entry {
+	if description != "" || len(entries) == 0 {
+		return append(entries, Entry{Title: title, Description: description})
+	}
+
+	prev := &entries[len(entries)-1]
+	if prev.Group == "" {
+		prev.Group = prev.Title
+	}
+	return append(entries, Entry{Title: title, Description: prev.Description, Group: prev.Group})
+}
@@ -0,0 +1,62 @@
+package docparse
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseManPage(t *testing.T) {
+	input := "% GHOSTTY(5)\n\n# NAME\n\nghostty - config\n\n# CONFIGURATION OPTIONS\n\n" +
+		"`font-family`\n\n:   The font families to use.\n\n    Repeat it for fallbacks.\n\n\n" +
+		"`font-family-bold`\n\n" +
+		"**`font-size`**\n\n:   Font size in points.\n\n    Available since: 1.0.1\n\n\n" +
+		"# KEYBIND ACTIONS\n\n`ignore`\n\n:   Ignore this key combination.\n"
+
+	entries, err := ParseManPage(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Entry{
+		{"font-family", "The font families to use.\n\nRepeat it for fallbacks.", "font-family"},
+		{"font-family-bold", "The font families to use.\n\nRepeat it for fallbacks.", "font-family"},
+		{"font-size", "Font size in points.\n\nAvailable since: 1.0.1", ""},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+
+	if since := Options(entries)[2].Since; since != "1.0.1" {
+		t.Errorf("font-size since = %q, want 1.0.1", since)
+	}
+}
+
+func TestParseShowConfig(t *testing.T) {
+	input := "# The font families to use.\n#\n# Repeat it for fallbacks.\nfont-family = \n\nfont-family-bold = \n\n" +
+		"# Keybinds.\nkeybind = ctrl+a=select_all\nkeybind = ctrl+c=copy_to_clipboard\n\n# Font size.\nfont-size = 13\n"
+
+	entries, err := ParseShowConfig(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Entry{
+		{"font-family", "The font families to use.\n\nRepeat it for fallbacks.", "font-family"},
+		{"font-family-bold", "The font families to use.\n\nRepeat it for fallbacks.", "font-family"},
+		{"keybind", "Keybinds.", ""},
+		{"font-size", "Font size.", ""},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package docparse
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+const (
+	platformMacOS = model.PlatformMacOS
+	platformLinux = model.PlatformLinux
+)
+
+// platformOverrides tags options whose docs don't say which platform they
+// are for in a way platformPatterns recognize.
+var platformOverrides = map[string][]string{
+	"x11-instance-name":             {platformLinux},
+	"class":                         {platformLinux},
+	"gtk-quick-terminal-layer":      {platformLinux},
+	"gtk-quick-terminal-namespace":  {platformLinux},
+	"quick-terminal-screen":         {platformMacOS},
+	"quick-terminal-space-behavior": {platformMacOS},
+	"window-colorspace":             {platformMacOS},
+	"auto-update":                   {platformMacOS},
+	"auto-update-channel":           {platformMacOS},
+	"async-backend":                 {platformLinux},
+	"window-titlebar-background":    {platformLinux},
+	"window-titlebar-foreground":    {platformLinux},
+}
+
+// platformPrefixes tags options by name prefix.
+var platformPrefixes = []struct {
+	prefix   string
+	platform string
+}{
+	{"macos-", platformMacOS},
+	{"gtk-", platformLinux},
+	{"linux-", platformLinux},
+}
+
+// platformPatterns match sentences in the docs that limit an option to
+// one platform.
+var platformPatterns = []struct {
+	pattern  *regexp.Regexp
+	platform string
+}{
+	{regexp.MustCompile(`(?i)(only supported on|only implemented on|only works on) macOS\b(\.| since| and has no)`), platformMacOS},
+	{regexp.MustCompile(`(?i)(only supported on|only implemented on) (Linux|GTK)\b( \(GTK\))?\.`), platformLinux},
+	{regexp.MustCompile(`(?i)only (affects|applies to) GTK( builds)?\.`), platformLinux},
+}
+
+// platformsOf returns the platforms an option is limited to, or nil if it
+// works everywhere.
+func platformsOf(title, description string) []string {
+	if platforms, ok := platformOverrides[title]; ok {
+		return platforms
+	}
+	for _, rule := range platformPrefixes {
+		if strings.HasPrefix(title, rule.prefix) {
+			return []string{rule.platform}
+		}
+	}
+
+	var platforms []string
+	for _, rule := range platformPatterns {
+		if rule.pattern.MatchString(description) && !slices.Contains(platforms, rule.platform) {
+			platforms = append(platforms, rule.platform)
+		}
+	}
+	if len(platforms) > 1 {
+		return nil // Documented for both, so it works everywhere
+	}
+	return platforms
+}
@@ -0,0 +1,155 @@
+package docparse
+
+import (
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxSimilar is how many similar options are kept per option.
+const maxSimilar = 5
+
+// minSimilarity is the cosine similarity below which options aren't
+// considered similar.
+const minSimilarity = 0.1
+
+// wordPattern matches the words descriptions are compared by.
+var wordPattern = regexp.MustCompile(`[a-z][a-z0-9]+`)
+
+// stopWords are words too common in the docs to tell options apart.
+var stopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "this": true, "that": true,
+	"with": true, "are": true, "not": true, "you": true, "can": true,
+	"will": true, "but": true, "from": true, "when": true, "any": true,
+	"all": true, "its": true, "has": true, "have": true, "was": true,
+	"which": true, "such": true, "only": true, "also": true, "more": true,
+	"then": true, "than": true, "into": true, "other": true, "some": true,
+	"use": true, "used": true, "set": true, "value": true, "values": true,
+	"default": true, "option": true, "options": true, "config": true,
+	"configuration": true, "ghostty": true, "may": true, "must": true,
+	"should": true, "does": true, "see": true, "one": true, "two": true,
+	"true": true, "false": true, "available": true, "since": true,
+	"example": true, "valid": true, "there": true, "these": true,
+	"what": true, "how": true, "your": true, "each": true, "where": true,
+	"would": true, "been": true, "they": true, "them": true, "their": true,
+	"what's": true, "like": true, "even": true, "currently": true,
+}
+
+// similarOptions fills in each option's Similar field with the options
+// whose title and description are closest by TF-IDF cosine similarity.
+// Options of the same group share a description, so they're skipped in
+// favor of the group's own links.
+// Weights are summed in term order, so regenerating the database gives the
+// same neighbours every time.
+func similarOptions(options []Option) {
+	frequencies := make([]map[string]float64, len(options))
+	df := map[string]int{}
+	for i, o := range options {
+		frequencies[i] = termFrequencies(o.Title, o.Description)
+		for term := range frequencies[i] {
+			df[term]++
+		}
+	}
+
+	docs := make([]vector, len(options))
+	for i, tf := range frequencies {
+		var norm float64
+		for _, term := range slices.Sorted(maps.Keys(tf)) {
+			w := tf[term] * math.Log(float64(len(options))/float64(df[term]))
+			docs[i] = append(docs[i], termWeight{term, w})
+			norm += w * w
+		}
+		norm = math.Sqrt(norm)
+		for j := range docs[i] {
+			if norm > 0 {
+				docs[i][j].weight /= norm
+			}
+		}
+	}
+
+	type neighbour struct {
+		title string
+		score float64
+	}
+	for i := range options {
+		var neighbours []neighbour
+		for j := range options {
+			if i == j || options[i].Group != "" && options[i].Group == options[j].Group {
+				continue
+			}
+			if score := dot(docs[i], docs[j]); score >= minSimilarity {
+				neighbours = append(neighbours, neighbour{options[j].Title, score})
+			}
+		}
+		slices.SortStableFunc(neighbours, func(a, b neighbour) int {
+			switch {
+			case a.score > b.score:
+				return -1
+			case a.score < b.score:
+				return 1
+			default:
+				return strings.Compare(a.title, b.title)
+			}
+		})
+
+		options[i].Similar = nil
+		for _, n := range neighbours[:min(len(neighbours), maxSimilar)] {
+			options[i].Similar = append(options[i].Similar, n.title)
+		}
+	}
+}
+
+// termFrequencies weighs the words of an option's title and the lead
+// paragraphs of its description, which say what the option is for; later
+// ones tend to cover platform quirks that make unrelated options look alike.
+func termFrequencies(title, description string) map[string]float64 {
+	paragraphs := strings.SplitN(description, "\n\n", 3)
+	lead := strings.Join(paragraphs[:min(len(paragraphs), 2)], "\n\n")
+	words := wordPattern.FindAllString(strings.ToLower(lead), -1)
+	titleWords := strings.Split(title, "-")
+	words = append(words, titleWords...)
+
+	tf := map[string]float64{}
+	total := 0
+	for _, w := range words {
+		if len(w) < 3 || stopWords[w] {
+			continue
+		}
+		tf[w]++
+		total++
+	}
+	for w, n := range tf {
+		// Dampen words repeated throughout one description
+		tf[w] = (1 + math.Log(n)) / float64(total)
+	}
+	return tf
+}
+
+// vector is a sparse TF-IDF vector, sorted by term.
+type vector []termWeight
+
+type termWeight struct {
+	term   string
+	weight float64
+}
+
+// dot returns the dot product of two vectors, summing shared terms in
+// order.
+func dot(a, b vector) float64 {
+	var sum float64
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch strings.Compare(a[i].term, b[j].term) {
+		case -1:
+			i++
+		case 1:
+			j++
+		default:
+			sum += a[i].weight * b[j].weight
+			i++
+			j++
+		}
+	}
+	return sum
+}
@@ -0,0 +1,48 @@
+package docparse
+
+// Synonyms maps phrases people search for to the options they mean, for
+// intents the docs don't phrase the same way. Phrases are lowercase and
+// use spaces between words.
+var Synonyms = map[string][]string{
+	"transparent":      {"background-opacity", "background-blur"},
+	"opacity":          {"background-opacity", "background-opacity-cells", "unfocused-split-opacity"},
+	"blur":             {"background-blur"},
+	"wallpaper":        {"background-image", "background-image-opacity", "background-image-fit"},
+	"hide titlebar":    {"macos-titlebar-style", "gtk-titlebar", "window-decoration"},
+	"no titlebar":      {"macos-titlebar-style", "gtk-titlebar", "window-decoration"},
+	"borderless":       {"window-decoration", "macos-titlebar-style"},
+	"tabs on the left": {"gtk-tabs-location", "window-new-tab-position"},
+	"tab bar":          {"window-show-tab-bar", "gtk-tabs-location", "gtk-wide-tabs"},
+	"copy paste":       {"copy-on-select", "clipboard-read", "clipboard-write", "clipboard-paste-protection"},
+	"copy on select":   {"copy-on-select"},
+	"line height":      {"adjust-cell-height"},
+	"line spacing":     {"adjust-cell-height"},
+	"letter spacing":   {"adjust-cell-width"},
+	"ligatures":        {"font-feature"},
+	"padding":          {"window-padding-x", "window-padding-y", "window-padding-balance"},
+	"margin":           {"window-padding-x", "window-padding-y"},
+	"window size":      {"window-width", "window-height", "window-save-state"},
+	"remember window":  {"window-save-state"},
+	"restore session":  {"window-save-state"},
+	"dropdown":         {"quick-terminal-position", "quick-terminal-size", "quick-terminal-autohide"},
+	"quake":            {"quick-terminal-position", "quick-terminal-size", "quick-terminal-autohide"},
+	"scrollback":       {"scrollback-limit"},
+	"history":          {"scrollback-limit"},
+	"blinking cursor":  {"cursor-style-blink"},
+	"cursor blink":     {"cursor-style-blink"},
+	"alt key":          {"macos-option-as-alt"},
+	"option key":       {"macos-option-as-alt"},
+	"meta key":         {"macos-option-as-alt"},
+	"hide mouse":       {"mouse-hide-while-typing"},
+	"scroll speed":     {"mouse-scroll-multiplier"},
+	"dark mode":        {"theme", "window-theme"},
+	"light mode":       {"theme", "window-theme"},
+	"color scheme":     {"theme", "palette"},
+	"shortcut":         {"keybind"},
+	"hotkey":           {"keybind"},
+	"startup command":  {"command", "initial-command"},
+	"default shell":    {"command"},
+	"confirm quit":     {"confirm-close-surface", "quit-after-last-window-closed"},
+	"inactive split":   {"unfocused-split-opacity", "unfocused-split-fill"},
+	"dim":              {"unfocused-split-opacity", "faint-opacity"},
+}
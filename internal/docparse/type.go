@@ -0,0 +1,43 @@
+package docparse
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Types are the kinds of value an option takes. The docs don't state them,
+// so they're inferred from each description.
+var Types = []string{"bool", "number", "color", "enum", "string"}
+
+// colorPattern matches descriptions of color values.
+var colorPattern = regexp.MustCompile("(?i)specified as either hex|hex \\(`#|#RRGGBB")
+
+// enumPattern matches descriptions listing the values an option accepts.
+var enumPattern = regexp.MustCompile(`(?i)(valid|available|possible|allowable) (values|options)|explicit values`)
+
+// boolPattern matches descriptions of options turned on or off.
+var boolPattern = regexp.MustCompile("(?i)^(whether|if `?true`?|enables? )|(if|when) (this is )?`?(true|false|enabled)`?|defaults? (to|value is|is) `?(true|false)`?")
+
+// numberPattern matches descriptions of numeric values.
+var numberPattern = regexp.MustCompile("(?i)\\b(in (points|pixels|bytes|milliseconds|seconds)|number of|a value of `?\\d|integer|floating point|multiplier|ratio|in pixels or percentage|amount of bytes)")
+
+// typeOf infers the kind of value an option takes from its docs. Only the
+// first paragraphs are checked for bools and numbers, as later ones often
+// describe parts of a larger value, like keybind prefixes.
+func typeOf(title, description string) string {
+	paragraphs := strings.SplitN(description, "\n\n", 3)
+	lead := strings.Join(paragraphs[:min(len(paragraphs), 2)], "\n\n")
+
+	switch {
+	case colorPattern.MatchString(description) || strings.HasSuffix(title, "-color") || title == "palette":
+		return "color"
+	case enumPattern.MatchString(description):
+		return "enum"
+	case boolPattern.MatchString(lead):
+		return "bool"
+	case numberPattern.MatchString(lead) || strings.HasPrefix(title, "adjust-") || strings.HasSuffix(title, "-opacity"):
+		return "number"
+	default:
+		return "string"
+	}
+}
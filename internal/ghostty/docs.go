@@ -0,0 +1,87 @@
+package ghostty
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/docparse"
+)
+
+// manPagePaths returns where the ghostty.5.md man page of an installed
+// Ghostty may be, most specific first: next to the binary on PATH, in
+// Ghostty's resources directory, then in the XDG data directories.
+func manPagePaths() []string {
+	var dirs []string
+
+	if bin, err := exec.LookPath("ghostty"); err == nil {
+		if resolved, err := filepath.EvalSymlinks(bin); err == nil {
+			bin = resolved
+		}
+		binDir := filepath.Dir(bin)
+		dirs = append(dirs,
+			filepath.Join(binDir, "..", "share", "ghostty"),
+			filepath.Join(binDir, "..", "Resources", "ghostty"), // macOS app bundle
+		)
+	}
+
+	if resources := os.Getenv("GHOSTTY_RESOURCES_DIR"); resources != "" {
+		dirs = append(dirs, resources)
+	}
+
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+	}
+	if dataHome != "" {
+		dirs = append(dirs, filepath.Join(dataHome, "ghostty"))
+	}
+
+	dataDirs := os.Getenv("XDG_DATA_DIRS")
+	if dataDirs == "" {
+		dataDirs = "/usr/local/share:/usr/share"
+	}
+	for _, dir := range filepath.SplitList(dataDirs) {
+		dirs = append(dirs, filepath.Join(dir, "ghostty"))
+	}
+
+	paths := make([]string, len(dirs))
+	for i, dir := range dirs {
+		paths[i] = filepath.Join(dir, "doc", "ghostty.5.md")
+	}
+	return paths
+}
+
+// LoadDocs reads the option docs of the installed Ghostty, from its man
+// page or, failing that, from `ghostty +show-config --default --docs`.
+// Returns where the docs were read from.
+func LoadDocs() ([]docparse.Entry, string, error) {
+	for _, path := range manPagePaths() {
+		file, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		entries, err := docparse.ParseManPage(file)
+		file.Close()
+		if err == nil && len(entries) > 0 {
+			return entries, path, nil
+		}
+	}
+
+	out, err := exec.Command("ghostty", "+show-config", "--default", "--docs").Output()
+	if err != nil {
+		return nil, "", fmt.Errorf("no installed Ghostty docs found: %w", err)
+	}
+	entries, err := docparse.ParseShowConfig(strings.NewReader(string(out)))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) == 0 {
+		return nil, "", fmt.Errorf("no options in ghostty +show-config output")
+	}
+	return entries, "ghostty +show-config --default --docs", nil
+}
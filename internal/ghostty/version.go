@@ -0,0 +1,83 @@
+package ghostty
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+)
+
+// Version is a Ghostty release version.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// versionPattern matches "1.2" or "1.2.3" anywhere in a string.
+var versionPattern = regexp.MustCompile(`(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// ParseVersion reads a version like "1.2.0" or "1.2". Surrounding text is
+// ignored, so "Ghostty 1.1.3" parses too.
+func ParseVersion(s string) (Version, error) {
+	match := versionPattern.FindStringSubmatch(s)
+	if match == nil {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+
+	var v Version
+	v.Major, _ = strconv.Atoi(match[1])
+	v.Minor, _ = strconv.Atoi(match[2])
+	if match[3] != "" {
+		v.Patch, _ = strconv.Atoi(match[3])
+	}
+	return v, nil
+}
+
+// String returns the version as "major.minor.patch".
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// IsZero reports whether the version is unknown.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Less reports whether v is older than other.
+func (v Version) Less(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// Supports reports whether a Ghostty of version v has a feature available
+// since the given version. An unknown installed version or an unknown
+// since version supports everything.
+func (v Version) Supports(since string) bool {
+	if v.IsZero() || since == "" {
+		return true
+	}
+	required, err := ParseVersion(since)
+	if err != nil {
+		return true
+	}
+	return !v.Less(required)
+}
+
+// Detect returns the version of the ghostty binary on PATH.
+func Detect() (Version, error) {
+	out, err := exec.Command("ghostty", "--version").Output()
+	if err != nil {
+		return Version{}, fmt.Errorf("running ghostty --version: %w", err)
+	}
+	return ParseVersion(string(out))
+}
+
+// Series returns the "major.minor" release series, which is how docs
+// versions are named.
+func (v Version) Series() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
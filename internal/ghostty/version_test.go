@@ -0,0 +1,56 @@
+package ghostty
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestSupports(t *testing.T) {
+	installed, err := ParseVersion("Ghostty 1.1.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		since string
+		want  bool
+	}{
+		{"", true},
+		{"1.0.0", true},
+		{"1.1.3", true},
+		{"1.1.4", false},
+		{"1.2.0", false},
+	}
+	for _, tt := range tests {
+		if got := installed.Supports(tt.since); got != tt.want {
+			t.Errorf("Supports(%q) = %v, want %v", tt.since, got, tt.want)
+		}
+	}
+
+	if !(Version{}).Supports("9.9.9") {
+		t.Error("An unknown version should support everything")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("stub binary is a shell script")
+	}
+
+	dir := t.TempDir()
+	stub := "#!/bin/sh\necho 'Ghostty 1.1.2'\necho\necho 'Version'\necho '  - channel: stable'\n"
+	if err := os.WriteFile(filepath.Join(dir, "ghostty"), []byte(stub), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+
+	v, err := Detect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "1.1.2" {
+		t.Errorf("Detect() = %s, want 1.1.2", v)
+	}
+}
@@ -0,0 +1,492 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// alacrittyFontStyles maps Alacritty font sections to Ghostty option suffixes.
+var alacrittyFontStyles = map[string]string{
+	"normal":      "",
+	"bold":        "-bold",
+	"italic":      "-italic",
+	"bold_italic": "-bold-italic",
+}
+
+// alacrittyActions maps Alacritty binding actions to Ghostty actions.
+var alacrittyActions = map[string]string{
+	"copy":              "copy_to_clipboard",
+	"paste":             "paste_from_clipboard",
+	"pasteselection":    "paste_from_selection",
+	"increasefontsize":  "increase_font_size:1",
+	"decreasefontsize":  "decrease_font_size:1",
+	"resetfontsize":     "reset_font_size",
+	"scrollpageup":      "scroll_page_up",
+	"scrollpagedown":    "scroll_page_down",
+	"scrolltotop":       "scroll_to_top",
+	"scrolltobottom":    "scroll_to_bottom",
+	"clearhistory":      "clear_screen",
+	"createnewwindow":   "new_window",
+	"spawnnewinstance":  "new_window",
+	"createnewtab":      "new_tab",
+	"selectnexttab":     "next_tab",
+	"selectprevioustab": "previous_tab",
+	"selectlasttab":     "last_tab",
+	"togglefullscreen":  "toggle_fullscreen",
+	"togglemaximized":   "toggle_maximize",
+	"quit":              "quit",
+	"none":              "ignore",
+	"receivechar":       "unbind",
+}
+
+// alacrittyKeys maps Alacritty key names to Ghostty key names.
+var alacrittyKeys = map[string]string{
+	"up":       "up",
+	"down":     "down",
+	"left":     "left",
+	"right":    "right",
+	"pageup":   "page_up",
+	"pagedown": "page_down",
+	"home":     "home",
+	"end":      "end",
+	"insert":   "insert",
+	"delete":   "delete",
+	"back":     "backspace",
+	"tab":      "tab",
+	"return":   "enter",
+	"enter":    "enter",
+	"space":    "space",
+	"escape":   "escape",
+	"plus":     "plus",
+	"minus":    "minus",
+	"equals":   "equal",
+	"comma":    "comma",
+	"period":   "period",
+	"slash":    "slash",
+}
+
+// alacrittyMods maps Alacritty modifier names to Ghostty modifiers.
+var alacrittyMods = map[string]string{
+	"control": "ctrl",
+	"shift":   "shift",
+	"alt":     "alt",
+	"option":  "alt",
+	"super":   "super",
+	"command": "super",
+}
+
+// alacrittyUnmappedReasons explains common options that can't be mapped.
+var alacrittyUnmappedReasons = map[string]string{
+	"import":                     "import the referenced files separately",
+	"general.import":             "import the referenced files separately",
+	"live_config_reload":         "use the reload_config action instead",
+	"general.live_config_reload": "use the reload_config action instead",
+	"scrolling.history":          "scrollback-limit is measured in bytes, not lines",
+}
+
+// Alacritty translates an alacritty.toml config into Ghostty options.
+func Alacritty(r io.Reader) (Result, error) {
+	var result Result
+
+	var doc map[string]any
+	if _, err := toml.NewDecoder(r).Decode(&doc); err != nil {
+		return result, fmt.Errorf("failed to parse alacritty config: %w", err)
+	}
+
+	values := map[string]any{}
+	flatten("", doc, values)
+
+	palette := map[int]string{}
+
+	for _, path := range sortedKeys(values) {
+		value := values[path]
+		parts := strings.Split(path, ".")
+
+		switch {
+		case path == "font.size":
+			if n, ok := toFloat(value); ok {
+				result.set("font-size", formatNumber(n), path)
+				continue
+			}
+
+		case len(parts) == 3 && parts[0] == "font" && (parts[2] == "family" || parts[2] == "style"):
+			suffix, ok := alacrittyFontStyles[parts[1]]
+			if s, isString := value.(string); ok && isString {
+				result.set("font-"+parts[2]+suffix, s, path)
+				continue
+			}
+
+		case path == "colors.primary.background", path == "colors.primary.foreground":
+			if setColor(&result, parts[2], value, path) {
+				continue
+			}
+
+		case path == "colors.cursor.cursor":
+			if setColor(&result, "cursor-color", value, path) {
+				continue
+			}
+
+		case path == "colors.cursor.text":
+			if setColor(&result, "cursor-text", value, path) {
+				continue
+			}
+
+		case path == "colors.selection.background":
+			if setColor(&result, "selection-background", value, path) {
+				continue
+			}
+
+		case path == "colors.selection.text":
+			if setColor(&result, "selection-foreground", value, path) {
+				continue
+			}
+
+		case len(parts) == 3 && parts[0] == "colors" && (parts[1] == "normal" || parts[1] == "bright"):
+			index := colorIndex(parts[2])
+			if parts[1] == "bright" {
+				index += 8
+			}
+			if s, ok := value.(string); ok && index >= 0 {
+				if color, err := normalizeColor(s); err == nil {
+					palette[index] = color
+					continue
+				}
+			}
+
+		case path == "colors.indexed_colors":
+			if items, ok := toTables(value); ok {
+				for _, item := range items {
+					index, iok := toFloat(item["index"])
+					s, sok := item["color"].(string)
+					if !iok || !sok {
+						continue
+					}
+					if color, err := normalizeColor(s); err == nil {
+						palette[int(index)] = color
+					}
+				}
+				continue
+			}
+
+		case path == "window.padding.x", path == "window.padding.y":
+			if n, ok := toFloat(value); ok {
+				result.set("window-padding-"+parts[2], formatNumber(n), path)
+				continue
+			}
+
+		case path == "window.dynamic_padding":
+			if b, ok := value.(bool); ok {
+				result.set("window-padding-balance", fmt.Sprint(b), path)
+				continue
+			}
+
+		case path == "window.opacity":
+			if n, ok := toFloat(value); ok {
+				result.set("background-opacity", formatNumber(n), path)
+				continue
+			}
+
+		case path == "window.blur":
+			if b, ok := value.(bool); ok {
+				result.set("background-blur", fmt.Sprint(b), path)
+				continue
+			}
+
+		case path == "window.decorations":
+			if mapDecorations(&result, value, path) {
+				continue
+			}
+
+		case path == "window.startup_mode":
+			switch strings.ToLower(fmt.Sprint(value)) {
+			case "maximized":
+				result.set("maximize", "true", path)
+				continue
+			case "fullscreen", "simplefullscreen":
+				result.set("fullscreen", "true", path)
+				continue
+			case "windowed":
+				continue
+			}
+
+		case path == "window.dimensions.columns":
+			if n, ok := toFloat(value); ok {
+				result.set("window-width", formatNumber(n), path)
+				continue
+			}
+
+		case path == "window.dimensions.lines":
+			if n, ok := toFloat(value); ok {
+				result.set("window-height", formatNumber(n), path)
+				continue
+			}
+
+		case path == "window.option_as_alt":
+			optionAsAlt := map[string]string{"both": "true", "onlyleft": "left", "onlyright": "right", "none": "false"}
+			if v, ok := optionAsAlt[strings.ToLower(fmt.Sprint(value))]; ok {
+				result.set("macos-option-as-alt", v, path)
+				continue
+			}
+
+		case path == "window.title":
+			if s, ok := value.(string); ok {
+				result.set("title", s, path)
+				continue
+			}
+
+		case path == "cursor.style", path == "cursor.style.shape":
+			cursorStyles := map[string]string{"block": "block", "underline": "underline", "beam": "bar"}
+			if v, ok := cursorStyles[strings.ToLower(fmt.Sprint(value))]; ok {
+				result.set("cursor-style", v, path)
+				continue
+			}
+
+		case path == "cursor.style.blinking":
+			switch strings.ToLower(fmt.Sprint(value)) {
+			case "never", "off":
+				result.set("cursor-style-blink", "false", path)
+				continue
+			case "on", "always":
+				result.set("cursor-style-blink", "true", path)
+				continue
+			}
+
+		case path == "selection.save_to_clipboard":
+			if b, ok := value.(bool); ok && b {
+				result.set("copy-on-select", "clipboard", path)
+				continue
+			}
+
+		case path == "mouse.hide_when_typing":
+			if b, ok := value.(bool); ok {
+				result.set("mouse-hide-while-typing", fmt.Sprint(b), path)
+				continue
+			}
+
+		case path == "terminal.shell", path == "shell", path == "terminal.shell.program", path == "shell.program":
+			if command := shellCommand(values, strings.TrimSuffix(path, ".program")); command != "" {
+				result.set("command", command, path)
+				continue
+			}
+
+		case path == "terminal.shell.args", path == "shell.args":
+			// Folded into command above
+			continue
+
+		case path == "keyboard.bindings":
+			if items, ok := toTables(value); ok {
+				for i, item := range items {
+					mapAlacrittyBinding(&result, item, fmt.Sprintf("%s[%d]", path, i))
+				}
+				continue
+			}
+		}
+
+		reason, ok := alacrittyUnmappedReasons[path]
+		if !ok {
+			reason = "no Ghostty equivalent"
+		}
+		result.skip(path, reason)
+	}
+
+	// Palette entries are emitted in index order
+	indices := make([]int, 0, len(palette))
+	for i := range palette {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+	for _, i := range indices {
+		result.set("palette", fmt.Sprintf("%d=%s", i, palette[i]), "colors")
+	}
+
+	return result, nil
+}
+
+// setColor sets a color option, or returns false if the value isn't a
+// color Ghostty understands.
+func setColor(result *Result, key string, value any, source string) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+
+	// Alacritty's CellForeground/CellBackground match Ghostty's cell colors
+	switch strings.ToLower(s) {
+	case "cellforeground":
+		result.set(key, "cell-foreground", source)
+		return true
+	case "cellbackground":
+		result.set(key, "cell-background", source)
+		return true
+	}
+
+	color, err := normalizeColor(s)
+	if err != nil {
+		return false
+	}
+	result.set(key, color, source)
+	return true
+}
+
+// mapDecorations translates Alacritty's window.decorations.
+func mapDecorations(result *Result, value any, source string) bool {
+	switch strings.ToLower(fmt.Sprint(value)) {
+	case "full":
+		result.set("window-decoration", "auto", source)
+	case "none":
+		result.set("window-decoration", "none", source)
+	case "transparent":
+		result.set("macos-titlebar-style", "transparent", source)
+	case "buttonless":
+		result.set("macos-titlebar-style", "hidden", source)
+	default:
+		return false
+	}
+	return true
+}
+
+// shellCommand builds a command line from a shell table or program path.
+func shellCommand(values map[string]any, prefix string) string {
+	if s, ok := values[prefix].(string); ok {
+		return s
+	}
+
+	program, ok := values[prefix+".program"].(string)
+	if !ok {
+		return ""
+	}
+
+	parts := []string{program}
+	if args, ok := values[prefix+".args"].([]any); ok {
+		for _, arg := range args {
+			parts = append(parts, fmt.Sprint(arg))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// mapAlacrittyBinding translates one entry of keyboard.bindings into a
+// Ghostty keybind, or records why it couldn't.
+func mapAlacrittyBinding(result *Result, binding map[string]any, source string) {
+	if mode, ok := binding["mode"]; ok {
+		result.skip(source, fmt.Sprintf("mode-specific binding (%v)", mode))
+		return
+	}
+
+	keyName, _ := binding["key"].(string)
+	key, ok := alacrittyKey(keyName)
+	if !ok {
+		result.skip(source, fmt.Sprintf("unknown key %q", keyName))
+		return
+	}
+
+	trigger := []string{}
+	if mods, ok := binding["mods"].(string); ok && mods != "" {
+		for _, mod := range strings.Split(mods, "|") {
+			m, ok := alacrittyMods[strings.ToLower(strings.TrimSpace(mod))]
+			if !ok {
+				result.skip(source, fmt.Sprintf("unknown modifier %q", mod))
+				return
+			}
+			trigger = append(trigger, m)
+		}
+	}
+	trigger = append(trigger, key)
+
+	var action string
+	if chars, ok := binding["chars"].(string); ok {
+		action = "text:" + escapeText(chars)
+	} else if name, ok := binding["action"].(string); ok {
+		lower := strings.ToLower(name)
+		if n, found := strings.CutPrefix(lower, "selecttab"); found && len(n) == 1 && n[0] >= '1' && n[0] <= '9' {
+			action = "goto_tab:" + n
+		} else if action, ok = alacrittyActions[lower]; !ok {
+			result.skip(source, fmt.Sprintf("unsupported action %q", name))
+			return
+		}
+	} else {
+		result.skip(source, "binding has no action or chars")
+		return
+	}
+
+	result.set("keybind", strings.Join(trigger, "+")+"="+action, source)
+}
+
+// alacrittyKey converts an Alacritty key name to a Ghostty key.
+func alacrittyKey(name string) (string, bool) {
+	if len(name) == 1 {
+		return strings.ToLower(name), true
+	}
+
+	lower := strings.ToLower(name)
+	if digit, ok := strings.CutPrefix(lower, "key"); ok && len(digit) == 1 && digit[0] >= '0' && digit[0] <= '9' {
+		return digit, true
+	}
+	if len(lower) >= 2 && lower[0] == 'f' && strings.Trim(lower[1:], "0123456789") == "" {
+		return lower, true
+	}
+
+	key, ok := alacrittyKeys[lower]
+	return key, ok
+}
+
+// escapeText writes chars using Zig string literal escapes, as expected
+// by Ghostty's text: action.
+func escapeText(chars string) string {
+	var b strings.Builder
+	for _, r := range chars {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\x%02x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// colorIndex returns the palette index of an ANSI color name, or -1.
+func colorIndex(name string) int {
+	for i, n := range ansiColorNames {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// toTables converts a TOML array of tables to a slice of maps.
+func toTables(value any) ([]map[string]any, bool) {
+	switch v := value.(type) {
+	case []map[string]any:
+		return v, true
+	case []any:
+		tables := make([]map[string]any, 0, len(v))
+		for _, item := range v {
+			table, ok := item.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			tables = append(tables, table)
+		}
+		return tables, true
+	}
+	return nil, false
+}
+
+// toFloat converts TOML numbers to float64.
+func toFloat(value any) (float64, bool) {
+	switch n := value.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
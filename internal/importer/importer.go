@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Setting is a single Ghostty option produced by an importer.
+type Setting struct {
+	Key    string
+	Value  string
+	Source string // The option it was translated from
+}
+
+// Line returns the setting as a config line.
+func (s Setting) Line() string {
+	return s.Key + " = " + s.Value
+}
+
+// Unmapped is a source option that has no Ghostty equivalent.
+type Unmapped struct {
+	Source string
+	Reason string
+}
+
+// Result is the outcome of an import.
+type Result struct {
+	Settings []Setting
+	Unmapped []Unmapped
+}
+
+// Lines returns the imported settings as config lines.
+func (r Result) Lines() []string {
+	lines := make([]string, 0, len(r.Settings))
+	for _, s := range r.Settings {
+		lines = append(lines, s.Line())
+	}
+	return lines
+}
+
+func (r *Result) set(key, value, source string) {
+	r.Settings = append(r.Settings, Setting{Key: key, Value: value, Source: source})
+}
+
+func (r *Result) skip(source, reason string) {
+	r.Unmapped = append(r.Unmapped, Unmapped{Source: source, Reason: reason})
+}
+
+// ansiColorNames are the names of the 8 base ANSI colors, in palette order.
+var ansiColorNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// normalizeColor converts "#RGB", "#RRGGBB" and "0xRRGGBB" colors to
+// the "#rrggbb" form Ghostty accepts.
+func normalizeColor(color string) (string, error) {
+	c := strings.TrimSpace(color)
+	c = strings.TrimPrefix(c, "#")
+	c = strings.TrimPrefix(strings.TrimPrefix(c, "0x"), "0X")
+
+	if len(c) == 3 {
+		c = string([]byte{c[0], c[0], c[1], c[1], c[2], c[2]})
+	}
+	if len(c) != 6 {
+		return "", fmt.Errorf("unsupported color %q", color)
+	}
+	if _, err := strconv.ParseUint(c, 16, 32); err != nil {
+		return "", fmt.Errorf("unsupported color %q", color)
+	}
+	return "#" + strings.ToLower(c), nil
+}
+
+// formatNumber renders a number the way it would be written by hand,
+// without trailing zeros.
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// flatten turns nested tables into a map of dotted paths to leaf values.
+// Arrays are kept as leaves.
+func flatten(prefix string, table map[string]any, out map[string]any) {
+	for k, v := range table {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			flatten(path, nested, out)
+			continue
+		}
+		out[path] = v
+	}
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
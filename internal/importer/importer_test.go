@@ -0,0 +1,124 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// hasLine reports whether the result contains the given config line.
+func hasLine(result Result, line string) bool {
+	for _, l := range result.Lines() {
+		if l == line {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAlacritty(t *testing.T) {
+	input := `
+[font]
+size = 13.5
+[font.normal]
+family = "JetBrains Mono"
+
+[colors.primary]
+background = "0x1d1f21"
+[colors.normal]
+red = "#cc6666"
+[colors.bright]
+white = "#fff"
+
+[window]
+opacity = 0.9
+padding = { x = 8, y = 4 }
+
+[cursor]
+style = { shape = "Beam" }
+
+[scrolling]
+history = 10000
+
+[keyboard]
+bindings = [
+  { key = "N", mods = "Control|Shift", action = "CreateNewWindow" },
+  { key = "M", mods = "Command", action = "Minimize" },
+]
+`
+	result, err := Alacritty(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Alacritty failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"font-size = 13.5",
+		"font-family = JetBrains Mono",
+		"background = #1d1f21",
+		"palette = 1=#cc6666",
+		"palette = 15=#ffffff",
+		"background-opacity = 0.9",
+		"window-padding-x = 8",
+		"window-padding-y = 4",
+		"cursor-style = bar",
+		"keybind = ctrl+shift+n=new_window",
+	} {
+		if !hasLine(result, want) {
+			t.Errorf("missing %q in %v", want, result.Lines())
+		}
+	}
+
+	if len(result.Unmapped) != 2 {
+		t.Errorf("got %d unmapped settings, want 2: %+v", len(result.Unmapped), result.Unmapped)
+	}
+}
+
+func TestKitty(t *testing.T) {
+	dir := t.TempDir()
+	theme := "background #1e1e2e\ncolor9 #f38ba8\ninclude my-kitty.conf\n"
+	if err := os.WriteFile(filepath.Join(dir, "theme.conf"), []byte(theme), 0644); err != nil {
+		t.Fatalf("Failed to write theme: %v", err)
+	}
+
+	input := `font_family	Fira Code
+include theme.conf
+window_padding_width 4 8
+cursor_shape beam
+kitty_mod ctrl+alt
+map kitty_mod+t new_tab
+map ctrl+shift+equal change_font_size all +2.0
+map f1 show_scrollback
+`
+	result, err := Kitty(strings.NewReader(input), filepath.Join(dir, "my-kitty.conf"))
+	if err != nil {
+		t.Fatalf("Kitty failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"font-family = Fira Code",
+		"background = #1e1e2e",
+		"palette = 9=#f38ba8",
+		"window-padding-x = 8",
+		"window-padding-y = 4",
+		"cursor-style = bar",
+		"keybind = ctrl+alt+t=new_tab",
+		"keybind = ctrl+shift+equal=increase_font_size:2",
+	} {
+		if !hasLine(result, want) {
+			t.Errorf("missing %q in %v", want, result.Lines())
+		}
+	}
+
+	if len(result.Unmapped) != 1 {
+		t.Errorf("got %d unmapped settings, want 1: %+v", len(result.Unmapped), result.Unmapped)
+	}
+
+	sources := map[string]string{}
+	for _, setting := range result.Settings {
+		sources[setting.Key] = setting.Source
+	}
+	if sources["font-family"] != "my-kitty.conf:1" || sources["background"] != "theme.conf:1" {
+		t.Errorf("got sources %v, want my-kitty.conf:1 and theme.conf:1", sources)
+	}
+}
@@ -0,0 +1,423 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// kittyFontOptions maps kitty font options to Ghostty options.
+var kittyFontOptions = map[string]string{
+	"font_family":      "font-family",
+	"bold_font":        "font-family-bold",
+	"italic_font":      "font-family-italic",
+	"bold_italic_font": "font-family-bold-italic",
+}
+
+// kittyColorOptions maps kitty color options to Ghostty options.
+var kittyColorOptions = map[string]string{
+	"background":           "background",
+	"foreground":           "foreground",
+	"cursor":               "cursor-color",
+	"cursor_text_color":    "cursor-text",
+	"selection_foreground": "selection-foreground",
+	"selection_background": "selection-background",
+}
+
+// kittyActions maps kitty actions that take no arguments to Ghostty actions.
+var kittyActions = map[string]string{
+	"copy_to_clipboard":    "copy_to_clipboard",
+	"paste_from_clipboard": "paste_from_clipboard",
+	"paste_from_selection": "paste_from_selection",
+	"new_os_window":        "new_window",
+	"new_window":           "new_split:auto",
+	"new_tab":              "new_tab",
+	"close_window":         "close_surface",
+	"close_tab":            "close_tab",
+	"close_os_window":      "close_window",
+	"next_tab":             "next_tab",
+	"previous_tab":         "previous_tab",
+	"move_tab_forward":     "move_tab:1",
+	"move_tab_backward":    "move_tab:-1",
+	"next_window":          "goto_split:next",
+	"previous_window":      "goto_split:previous",
+	"scroll_page_up":       "scroll_page_up",
+	"scroll_page_down":     "scroll_page_down",
+	"scroll_home":          "scroll_to_top",
+	"scroll_end":           "scroll_to_bottom",
+	"scroll_line_up":       "scroll_page_lines:-1",
+	"scroll_line_down":     "scroll_page_lines:1",
+	"toggle_fullscreen":    "toggle_fullscreen",
+	"toggle_maximized":     "toggle_maximize",
+	"edit_config_file":     "open_config",
+	"load_config_file":     "reload_config",
+	"quit":                 "quit",
+	"no_op":                "ignore",
+	"discard_event":        "ignore",
+}
+
+// kittyUnmappedReasons explains common options that can't be mapped.
+var kittyUnmappedReasons = map[string]string{
+	"scrollback_lines": "scrollback-limit is measured in bytes, not lines",
+	"envinclude":       "environment includes are not supported",
+}
+
+// kittyLine is a single option line from a kitty config.
+type kittyLine struct {
+	key    string
+	value  string
+	source string // file:line
+}
+
+// Kitty translates a kitty.conf into Ghostty options. path is where the
+// config was read from: sources are reported by its name, and relative
+// `include` and `globinclude` paths are resolved against its directory.
+func Kitty(r io.Reader, path string) (Result, error) {
+	var result Result
+
+	// An include pointing back at the config itself is a cycle too
+	path = filepath.Clean(path)
+	seen := map[string]bool{path: true}
+	lines, err := readKittyLines(r, filepath.Base(path), filepath.Dir(path), seen)
+	if err != nil {
+		return result, err
+	}
+
+	// kitty_mod can be changed anywhere in the file and applies to every map
+	kittyMod := "ctrl+shift"
+	for _, line := range lines {
+		if line.key == "kitty_mod" {
+			kittyMod = line.value
+		}
+	}
+
+	var padding []string
+	paddingSource := ""
+
+	for _, line := range lines {
+		key, value, source := line.key, line.value, line.source
+
+		switch {
+		case key == "kitty_mod":
+			continue
+
+		case kittyFontOptions[key] != "":
+			if value == "auto" {
+				continue // Ghostty already derives styles from font-family
+			}
+			result.set(kittyFontOptions[key], value, source)
+			continue
+
+		case key == "font_size":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				result.set("font-size", formatNumber(n), source)
+				continue
+			}
+
+		case kittyColorOptions[key] != "":
+			if setColor(&result, kittyColorOptions[key], value, source) {
+				continue
+			}
+			if value == "none" {
+				continue // kitty's default, which is Ghostty's too
+			}
+
+		case strings.HasPrefix(key, "color"):
+			index, err := strconv.Atoi(strings.TrimPrefix(key, "color"))
+			if err == nil && index >= 0 && index <= 255 {
+				if color, err := normalizeColor(value); err == nil {
+					result.set("palette", fmt.Sprintf("%d=%s", index, color), source)
+					continue
+				}
+			}
+
+		case key == "window_padding_width":
+			padding = strings.Fields(value)
+			paddingSource = source
+			continue
+
+		case key == "background_opacity":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				result.set("background-opacity", formatNumber(n), source)
+				continue
+			}
+
+		case key == "background_blur":
+			if n, err := strconv.Atoi(value); err == nil {
+				if n > 0 {
+					result.set("background-blur", strconv.Itoa(n), source)
+				}
+				continue
+			}
+
+		case key == "cursor_shape":
+			cursorStyles := map[string]string{"block": "block", "beam": "bar", "underline": "underline"}
+			if v, ok := cursorStyles[value]; ok {
+				result.set("cursor-style", v, source)
+				continue
+			}
+
+		case key == "cursor_blink_interval":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				result.set("cursor-style-blink", fmt.Sprint(n != 0), source)
+				continue
+			}
+
+		case key == "hide_window_decorations":
+			switch value {
+			case "yes":
+				result.set("window-decoration", "none", source)
+				continue
+			case "no":
+				continue
+			case "titlebar-only":
+				result.set("macos-titlebar-style", "hidden", source)
+				continue
+			}
+
+		case key == "copy_on_select":
+			switch value {
+			case "clipboard", "yes":
+				result.set("copy-on-select", "clipboard", source)
+				continue
+			case "no":
+				result.set("copy-on-select", "false", source)
+				continue
+			}
+
+		case key == "macos_option_as_alt":
+			optionAsAlt := map[string]string{"yes": "true", "both": "true", "left": "left", "right": "right", "no": "false", "none": "false"}
+			if v, ok := optionAsAlt[value]; ok {
+				result.set("macos-option-as-alt", v, source)
+				continue
+			}
+
+		case key == "shell":
+			if value != "." {
+				result.set("command", value, source)
+			}
+			continue
+
+		case key == "map":
+			mapKittyShortcut(&result, value, kittyMod, source)
+			continue
+		}
+
+		reason, ok := kittyUnmappedReasons[key]
+		if !ok {
+			reason = "no Ghostty equivalent"
+		}
+		result.skip(source+" "+key, reason)
+	}
+
+	if len(padding) > 0 {
+		mapKittyPadding(&result, padding, paddingSource)
+	}
+
+	return result, nil
+}
+
+// readKittyLines reads option lines, expanding includes in place.
+func readKittyLines(r io.Reader, name, dir string, seen map[string]bool) ([]kittyLine, error) {
+	var lines []kittyLine
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Options are separated from their value by any whitespace
+		key, value := line, ""
+		if i := strings.IndexAny(line, " \t"); i != -1 {
+			key, value = line[:i], strings.TrimSpace(line[i+1:])
+		}
+		source := fmt.Sprintf("%s:%d", name, lineNum)
+
+		switch key {
+		case "include", "globinclude":
+			pattern := os.ExpandEnv(value)
+			if strings.HasPrefix(pattern, "~/") {
+				if home, err := os.UserHomeDir(); err == nil {
+					pattern = filepath.Join(home, pattern[2:])
+				}
+			}
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(dir, pattern)
+			}
+
+			paths := []string{pattern}
+			if key == "globinclude" {
+				matches, err := filepath.Glob(pattern)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", source, err)
+				}
+				paths = matches
+			}
+
+			for _, path := range paths {
+				included, err := readKittyFile(path, seen)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", source, err)
+				}
+				lines = append(lines, included...)
+			}
+			continue
+		}
+
+		lines = append(lines, kittyLine{key: key, value: value, source: source})
+	}
+
+	return lines, scanner.Err()
+}
+
+// readKittyFile reads an included file once, skipping include cycles.
+func readKittyFile(path string, seen map[string]bool) ([]kittyLine, error) {
+	if seen[path] {
+		return nil, nil
+	}
+	seen[path] = true
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return readKittyLines(file, filepath.Base(path), filepath.Dir(path), seen)
+}
+
+// mapKittyPadding translates window_padding_width, which takes 1, 2 or 4
+// values in CSS order (top, right, bottom, left).
+func mapKittyPadding(result *Result, values []string, source string) {
+	var top, right, bottom, left string
+	switch len(values) {
+	case 1:
+		top, right, bottom, left = values[0], values[0], values[0], values[0]
+	case 2:
+		top, right, bottom, left = values[0], values[1], values[0], values[1]
+	case 3:
+		top, right, bottom, left = values[0], values[1], values[2], values[1]
+	default:
+		top, right, bottom, left = values[0], values[1], values[2], values[3]
+	}
+
+	result.set("window-padding-x", joinPadding(left, right), source)
+	result.set("window-padding-y", joinPadding(top, bottom), source)
+}
+
+// joinPadding writes a Ghostty padding value, which is a single number
+// when both sides match.
+func joinPadding(a, b string) string {
+	if a == b {
+		return a
+	}
+	return a + "," + b
+}
+
+// mapKittyShortcut translates a `map` line into a Ghostty keybind.
+func mapKittyShortcut(result *Result, value, kittyMod, source string) {
+	source += " map"
+
+	fields := strings.Fields(value)
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+		result.skip(source, fmt.Sprintf("conditional mapping (%s)", fields[0]))
+		return
+	}
+	if len(fields) < 2 {
+		result.skip(source, "map has no action")
+		return
+	}
+
+	trigger := strings.ReplaceAll(fields[0], "kitty_mod", kittyMod)
+
+	action, ok := kittyAction(fields[1], fields[2:])
+	if !ok {
+		result.skip(source, fmt.Sprintf("unsupported action %q", strings.Join(fields[1:], " ")))
+		return
+	}
+
+	result.set("keybind", trigger+"="+action, source)
+}
+
+// kittyAction translates a kitty action and its arguments.
+func kittyAction(name string, args []string) (string, bool) {
+	if len(args) == 0 {
+		action, ok := kittyActions[name]
+		return action, ok
+	}
+
+	switch name {
+	case "goto_tab":
+		if n, err := strconv.Atoi(args[0]); err == nil && n > 0 {
+			return fmt.Sprintf("goto_tab:%d", n), true
+		}
+
+	case "change_font_size":
+		// change_font_size all|current [+-]N
+		if len(args) == 2 {
+			amount, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				break
+			}
+			switch {
+			case amount == 0:
+				return "reset_font_size", true
+			case strings.HasPrefix(args[1], "+"):
+				return "increase_font_size:" + formatNumber(amount), true
+			case strings.HasPrefix(args[1], "-"):
+				return "decrease_font_size:" + formatNumber(-amount), true
+			default:
+				return "set_font_size:" + formatNumber(amount), true
+			}
+		}
+
+	case "neighboring_window":
+		directions := map[string]string{"left": "left", "right": "right", "top": "up", "bottom": "down", "up": "up", "down": "down"}
+		if d, ok := directions[args[0]]; ok {
+			return "goto_split:" + d, true
+		}
+
+	case "send_text":
+		// send_text <modes> <text>
+		if len(args) >= 2 {
+			return "text:" + strings.Join(args[1:], " "), true
+		}
+
+	case "clear_terminal":
+		switch args[0] {
+		case "reset":
+			return "reset", true
+		case "clear", "scrollback", "to_cursor":
+			return "clear_screen", true
+		}
+
+	case "toggle_layout":
+		if args[0] == "stack" {
+			return "toggle_split_zoom", true
+		}
+
+	case "launch":
+		for _, arg := range args {
+			switch arg {
+			case "--location=vsplit":
+				return "new_split:right", true
+			case "--location=hsplit":
+				return "new_split:down", true
+			case "--type=tab":
+				return "new_tab", true
+			case "--type=os-window":
+				return "new_window", true
+			}
+		}
+	}
+
+	return "", false
+}
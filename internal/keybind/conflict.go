@@ -0,0 +1,215 @@
+package keybind
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/config"
+)
+
+// Kind identifies the type of a keybind conflict.
+type Kind int
+
+const (
+	// Duplicate means the same trigger is bound more than once in the
+	// user's config; only the last binding takes effect.
+	Duplicate Kind = iota
+	// Shadowed means a sequence prefix and a single-key binding collide,
+	// so one of them can never fire.
+	Shadowed
+	// OverridesDefault means a user binding replaces or unbinds one of
+	// Ghostty's default bindings.
+	OverridesDefault
+	// Invalid means a keybind line could not be parsed.
+	Invalid
+)
+
+// String returns a short label for the kind.
+func (k Kind) String() string {
+	switch k {
+	case Duplicate:
+		return "duplicate"
+	case Shadowed:
+		return "shadowed"
+	case OverridesDefault:
+		return "overrides default"
+	case Invalid:
+		return "invalid"
+	default:
+		return "unknown"
+	}
+}
+
+// IsWarning reports whether the conflict is likely a mistake rather than
+// an intentional customization.
+func (k Kind) IsWarning() bool {
+	return k != OverridesDefault
+}
+
+// Conflict describes a problem between two bindings. Binding is the one
+// the conflict is reported on; Other is the binding it collides with.
+type Conflict struct {
+	Kind    Kind
+	Binding Binding
+	Other   *Binding
+	Message string
+}
+
+// Report is the result of analyzing a config's keybinds.
+type Report struct {
+	Bindings  []Binding  // User bindings in config order
+	Conflicts []Conflict // Conflicts in config order
+}
+
+// Warnings returns the number of conflicts that are likely mistakes.
+func (r Report) Warnings() int {
+	n := 0
+	for _, c := range r.Conflicts {
+		if c.Kind.IsWarning() {
+			n++
+		}
+	}
+	return n
+}
+
+// ConflictsFor returns the conflicts reported on the given binding.
+func (r Report) ConflictsFor(b Binding) []Conflict {
+	var result []Conflict
+	for _, c := range r.Conflicts {
+		if c.Binding.File == b.File && c.Binding.Line == b.Line {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Check parses every `keybind` entry in a config and analyzes them.
+// Lines that fail to parse are reported as Invalid conflicts.
+func Check(entries []config.Entry, defaults []Binding) Report {
+	var all, bindings []Binding
+	var invalid []Conflict
+
+	for _, e := range entries {
+		if e.Key != "keybind" {
+			continue
+		}
+
+		b, err := Parse(e.Value)
+		b.File = e.File
+		b.Line = e.Line
+		all = append(all, b)
+		if err != nil {
+			invalid = append(invalid, Conflict{Kind: Invalid, Binding: b, Message: err.Error()})
+			continue
+		}
+		bindings = append(bindings, b)
+	}
+
+	report := Analyze(bindings, defaults)
+	report.Bindings = all
+	report.Conflicts = append(invalid, report.Conflicts...)
+
+	// Keep conflicts in config order
+	order := map[string]int{}
+	for i, b := range all {
+		order[b.Location()] = i
+	}
+	sort.SliceStable(report.Conflicts, func(i, j int) bool {
+		return order[report.Conflicts[i].Binding.Location()] < order[report.Conflicts[j].Binding.Location()]
+	})
+	return report
+}
+
+// Analyze checks user bindings against each other and against defaults.
+// Bindings that come before a `keybind = clear` are ignored for default
+// comparisons, since clear removes the defaults too.
+func Analyze(bindings []Binding, defaults []Binding) Report {
+	var report Report
+
+	// Triggers bound so far, keyed by normalized trigger sequence
+	active := map[string]Binding{}
+	for _, d := range defaults {
+		d.Default = true
+		active[d.Trigger()] = d
+	}
+
+	for _, b := range bindings {
+		if b.Clear {
+			active = map[string]Binding{}
+			report.Bindings = append(report.Bindings, b)
+			continue
+		}
+
+		trigger := b.Trigger()
+		report.Bindings = append(report.Bindings, b)
+
+		if prev, ok := active[trigger]; ok {
+			prev := prev
+			if prev.Default {
+				msg := fmt.Sprintf("`%s` overrides default `%s`", trigger, prev.Action)
+				if b.IsUnbind() {
+					msg = fmt.Sprintf("`%s` unbinds default `%s`", trigger, prev.Action)
+				}
+				if prev.Action != b.Action {
+					report.Conflicts = append(report.Conflicts, Conflict{
+						Kind: OverridesDefault, Binding: b, Other: &prev, Message: msg,
+					})
+				}
+			} else {
+				report.Conflicts = append(report.Conflicts, Conflict{
+					Kind:    Duplicate,
+					Binding: b,
+					Other:   &prev,
+					Message: fmt.Sprintf("`%s` is already bound to `%s` at %s", trigger, prev.Action, prev.Location()),
+				})
+			}
+		}
+
+		if b.IsUnbind() {
+			delete(active, trigger)
+			continue
+		}
+
+		for _, other := range sortedTriggers(active) {
+			prev := active[other]
+			switch {
+			case isPrefix(other, trigger):
+				// A sequence overrides a previously bound prefix
+				report.Conflicts = append(report.Conflicts, Conflict{
+					Kind:    Shadowed,
+					Binding: b,
+					Other:   &prev,
+					Message: fmt.Sprintf("sequence `%s` makes `%s` (%s, %s) do nothing", trigger, other, prev.Action, prev.Location()),
+				})
+			case isPrefix(trigger, other):
+				// A single binding unbinds every sequence it prefixes
+				report.Conflicts = append(report.Conflicts, Conflict{
+					Kind:    Shadowed,
+					Binding: b,
+					Other:   &prev,
+					Message: fmt.Sprintf("`%s` unbinds sequence `%s` (%s, %s)", trigger, other, prev.Action, prev.Location()),
+				})
+				delete(active, other)
+			}
+		}
+
+		active[trigger] = b
+	}
+
+	return report
+}
+
+// isPrefix reports whether sequence prefix is a strict prefix of seq.
+func isPrefix(prefix, seq string) bool {
+	return strings.HasPrefix(seq, prefix+">")
+}
+
+func sortedTriggers(bindings map[string]Binding) []string {
+	triggers := make([]string, 0, len(bindings))
+	for t := range bindings {
+		triggers = append(triggers, t)
+	}
+	sort.Strings(triggers)
+	return triggers
+}
@@ -0,0 +1,170 @@
+package keybind
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// commonDefaults are default bindings shared by every platform.
+var commonDefaults = []string{
+	"shift+page_up=scroll_page_up",
+	"shift+page_down=scroll_page_down",
+	"shift+home=scroll_to_top",
+	"shift+end=scroll_to_bottom",
+	"shift+insert=paste_from_selection",
+}
+
+// linuxDefaults are Ghostty's default bindings on Linux (GTK).
+var linuxDefaults = []string{
+	"ctrl+shift+c=copy_to_clipboard",
+	"ctrl+shift+v=paste_from_clipboard",
+	"ctrl+shift+a=select_all",
+	"ctrl+comma=open_config",
+	"ctrl+shift+comma=reload_config",
+	"ctrl+equal=increase_font_size:1",
+	"ctrl+plus=increase_font_size:1",
+	"ctrl+minus=decrease_font_size:1",
+	"ctrl+zero=reset_font_size",
+	"ctrl+shift+j=write_screen_file:paste",
+	"ctrl+shift+alt+j=write_screen_file:open",
+	"ctrl+shift+n=new_window",
+	"ctrl+shift+w=close_tab",
+	"ctrl+shift+q=quit",
+	"ctrl+shift+t=new_tab",
+	"ctrl+shift+left=previous_tab",
+	"ctrl+shift+right=next_tab",
+	"ctrl+page_up=previous_tab",
+	"ctrl+page_down=next_tab",
+	"ctrl+tab=next_tab",
+	"ctrl+shift+tab=previous_tab",
+	"alt+1=goto_tab:1",
+	"alt+2=goto_tab:2",
+	"alt+3=goto_tab:3",
+	"alt+4=goto_tab:4",
+	"alt+5=goto_tab:5",
+	"alt+6=goto_tab:6",
+	"alt+7=goto_tab:7",
+	"alt+8=goto_tab:8",
+	"alt+9=last_tab",
+	"ctrl+shift+o=new_split:right",
+	"ctrl+shift+e=new_split:down",
+	"ctrl+super+[=goto_split:previous",
+	"ctrl+super+]=goto_split:next",
+	"ctrl+alt+up=goto_split:up",
+	"ctrl+alt+down=goto_split:down",
+	"ctrl+alt+left=goto_split:left",
+	"ctrl+alt+right=goto_split:right",
+	"ctrl+shift+enter=toggle_split_zoom",
+	"ctrl+shift+i=inspector:toggle",
+	"ctrl+shift+page_up=jump_to_prompt:-1",
+	"ctrl+shift+page_down=jump_to_prompt:1",
+	"ctrl+enter=toggle_fullscreen",
+}
+
+// darwinDefaults are Ghostty's default bindings on macOS.
+var darwinDefaults = []string{
+	"super+c=copy_to_clipboard",
+	"super+v=paste_from_clipboard",
+	"super+a=select_all",
+	"super+comma=open_config",
+	"super+shift+comma=reload_config",
+	"super+k=clear_screen",
+	"super+equal=increase_font_size:1",
+	"super+plus=increase_font_size:1",
+	"super+minus=decrease_font_size:1",
+	"super+zero=reset_font_size",
+	"super+n=new_window",
+	"super+w=close_surface",
+	"super+shift+w=close_window",
+	"super+alt+w=close_tab",
+	"super+q=quit",
+	"super+t=new_tab",
+	"super+shift+[=previous_tab",
+	"super+shift+]=next_tab",
+	"super+1=goto_tab:1",
+	"super+2=goto_tab:2",
+	"super+3=goto_tab:3",
+	"super+4=goto_tab:4",
+	"super+5=goto_tab:5",
+	"super+6=goto_tab:6",
+	"super+7=goto_tab:7",
+	"super+8=goto_tab:8",
+	"super+9=last_tab",
+	"super+d=new_split:right",
+	"super+shift+d=new_split:down",
+	"super+[=goto_split:previous",
+	"super+]=goto_split:next",
+	"super+alt+up=goto_split:up",
+	"super+alt+down=goto_split:down",
+	"super+alt+left=goto_split:left",
+	"super+alt+right=goto_split:right",
+	"super+shift+enter=toggle_split_zoom",
+	"super+enter=toggle_fullscreen",
+	"super+ctrl+f=toggle_fullscreen",
+	"super+alt+i=inspector:toggle",
+	"super+home=scroll_to_top",
+	"super+end=scroll_to_bottom",
+	"super+page_up=scroll_page_up",
+	"super+page_down=scroll_page_down",
+	"super+up=jump_to_prompt:-1",
+	"super+down=jump_to_prompt:1",
+}
+
+// Defaults returns the bundled default bindings for a platform (a
+// runtime.GOOS value). The table is a snapshot of Ghostty's defaults;
+// use ParseDefaults with `ghostty +list-keybinds --default` output for
+// the exact set of an installed Ghostty.
+func Defaults(goos string) []Binding {
+	lines := append([]string{}, commonDefaults...)
+	if goos == "darwin" {
+		lines = append(lines, darwinDefaults...)
+	} else {
+		lines = append(lines, linuxDefaults...)
+	}
+
+	var bindings []Binding
+	for _, line := range lines {
+		b, err := Parse(line)
+		if err != nil {
+			continue
+		}
+		b.Default = true
+		bindings = append(bindings, b)
+	}
+	return bindings
+}
+
+// ParseDefaults reads the output of `ghostty +list-keybinds --default`.
+// Both the plain `keybind = trigger=action` form and bare `trigger=action`
+// lines are accepted.
+func ParseDefaults(r io.Reader) ([]Binding, error) {
+	var bindings []Binding
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(line, "keybind"); ok {
+			rest = strings.TrimSpace(rest)
+			if strings.HasPrefix(rest, "=") {
+				line = strings.TrimSpace(rest[1:])
+			}
+		}
+
+		b, err := Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		b.Default = true
+		bindings = append(bindings, b)
+	}
+
+	return bindings, scanner.Err()
+}
@@ -0,0 +1,203 @@
+package keybind
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// modifierOrder is the canonical order modifiers are printed in.
+var modifierOrder = []string{"ctrl", "alt", "shift", "super"}
+
+// modifierAliases maps every accepted modifier spelling to its canonical name.
+var modifierAliases = map[string]string{
+	"shift":   "shift",
+	"ctrl":    "ctrl",
+	"control": "ctrl",
+	"alt":     "alt",
+	"opt":     "alt",
+	"option":  "alt",
+	"super":   "super",
+	"cmd":     "super",
+	"command": "super",
+}
+
+// triggerPrefixes are the special values a trigger may be prefixed with.
+var triggerPrefixes = []string{"all", "global", "unconsumed", "performable", "physical"}
+
+// Binding is a single parsed `keybind` value.
+type Binding struct {
+	Raw      string   // Value as written in the config
+	Prefixes []string // e.g. "global", "unconsumed"
+	Sequence []string // Normalized triggers, one per step of a sequence
+	Action   string   // e.g. "new_split:right"
+	Clear    bool     // true for `keybind = clear`
+	Default  bool     // true if this comes from Ghostty's defaults
+	File     string
+	Line     int
+}
+
+// Trigger returns the normalized trigger sequence, e.g. "ctrl+a>n".
+func (b Binding) Trigger() string {
+	return strings.Join(b.Sequence, ">")
+}
+
+// Location returns the "file:line" the binding comes from.
+func (b Binding) Location() string {
+	if b.Default {
+		return "default"
+	}
+	return fmt.Sprintf("%s:%d", b.File, b.Line)
+}
+
+// IsUnbind reports whether the binding removes a trigger.
+func (b Binding) IsUnbind() bool {
+	return b.Action == "unbind"
+}
+
+// Parse parses a keybind value in the `trigger=action` format.
+func Parse(value string) (Binding, error) {
+	b := Binding{Raw: value}
+
+	value = strings.TrimSpace(value)
+	if value == "clear" {
+		b.Clear = true
+		return b, nil
+	}
+
+	// The action may itself contain "=" (e.g. text:a=b), so split on the
+	// first one that follows the trigger. Triggers can bind "=" as a key,
+	// which is written as "ctrl+=" and is handled by skipping a "=" that
+	// directly follows "+".
+	sep := -1
+	for i := 0; i < len(value); i++ {
+		if value[i] == '=' && (i == 0 || value[i-1] != '+') {
+			sep = i
+			break
+		}
+	}
+	if sep <= 0 {
+		return b, fmt.Errorf("invalid keybind %q: expected trigger=action", value)
+	}
+
+	trigger := value[:sep]
+	b.Action = strings.TrimSpace(value[sep+1:])
+	if b.Action == "" {
+		return b, fmt.Errorf("invalid keybind %q: missing action", value)
+	}
+
+	// Strip prefixes like global: and unconsumed:
+	for {
+		found := false
+		for _, p := range triggerPrefixes {
+			if strings.HasPrefix(trigger, p+":") {
+				trigger = trigger[len(p)+1:]
+				if p != "physical" {
+					b.Prefixes = append(b.Prefixes, p)
+				}
+				found = true
+			}
+		}
+		if !found {
+			break
+		}
+	}
+
+	for _, step := range strings.Split(trigger, ">") {
+		normalized, err := NormalizeTrigger(step)
+		if err != nil {
+			return b, fmt.Errorf("invalid keybind %q: %w", value, err)
+		}
+		b.Sequence = append(b.Sequence, normalized)
+	}
+
+	if len(b.Sequence) > 1 && (b.hasPrefix("global") || b.hasPrefix("all")) {
+		return b, fmt.Errorf("invalid keybind %q: sequences are not allowed for global: or all: triggers", value)
+	}
+
+	return b, nil
+}
+
+func (b Binding) hasPrefix(prefix string) bool {
+	for _, p := range b.Prefixes {
+		if p == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeTrigger returns the canonical form of a single trigger so that
+// equivalent spellings compare equal: modifier aliases are resolved,
+// modifiers are ordered, codepoints are case folded and W3C key codes are
+// converted to snake case (KeyA and key_a are the same key).
+func NormalizeTrigger(trigger string) (string, error) {
+	trigger = strings.TrimSpace(trigger)
+	if trigger == "" {
+		return "", fmt.Errorf("empty trigger")
+	}
+
+	mods := map[string]bool{}
+	key := ""
+
+	parts := strings.Split(trigger, "+")
+	for i := 0; i < len(parts); i++ {
+		part := parts[i]
+
+		// A literal "+" key shows up as an empty part
+		if part == "" {
+			part = "+"
+			if i+1 < len(parts) && parts[i+1] == "" {
+				i++
+			}
+		}
+
+		if mod, ok := modifierAliases[strings.ToLower(part)]; ok {
+			if mods[mod] {
+				return "", fmt.Errorf("modifier %q repeated in %q", mod, trigger)
+			}
+			mods[mod] = true
+			continue
+		}
+
+		if key != "" {
+			return "", fmt.Errorf("only a single key is allowed in %q", trigger)
+		}
+		key = normalizeKey(part)
+	}
+
+	if key == "" {
+		return "", fmt.Errorf("no key in %q", trigger)
+	}
+
+	var b strings.Builder
+	for _, mod := range modifierOrder {
+		if mods[mod] {
+			b.WriteString(mod)
+			b.WriteString("+")
+		}
+	}
+	b.WriteString(key)
+	return b.String(), nil
+}
+
+// normalizeKey case folds single codepoints and converts W3C key codes
+// such as "ArrowUp" to their snake case form "arrow_up".
+func normalizeKey(key string) string {
+	if utf8.RuneCountInString(key) == 1 {
+		return strings.ToLower(key)
+	}
+
+	var b strings.Builder
+	for i, r := range key {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package keybind
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/intaek-h/ghofig/internal/config"
+)
+
+func TestNormalizeTrigger(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"ctrl+a", "ctrl+a"},
+		{"Control+A", "ctrl+a"},
+		{"shift+a+ctrl", "ctrl+shift+a"},
+		{"cmd+opt+KeyA", "alt+super+key_a"},
+		{"super+PageUp", "super+page_up"},
+		{"ctrl++", "ctrl++"},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeTrigger(tt.in)
+		if err != nil {
+			t.Errorf("NormalizeTrigger(%q) failed: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeTrigger(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"ctrl+ctrl+a", "ctrl+a+b", "ctrl"} {
+		if _, err := NormalizeTrigger(bad); err == nil {
+			t.Errorf("NormalizeTrigger(%q) should fail", bad)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	input := `keybind = ctrl+a=new_window
+keybind = ctrl+a>n=new_tab
+keybind = global:ctrl+shift+x=toggle_quick_terminal
+keybind = control+shift+X=reload_config
+keybind = ctrl+shift+t=unbind
+keybind = ctrl+ctrl+q=quit
+`
+	entries, err := config.Parse(strings.NewReader(input), "config")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	defaults, err := ParseDefaults(strings.NewReader("keybind = ctrl+shift+t=new_tab\n"))
+	if err != nil {
+		t.Fatalf("ParseDefaults failed: %v", err)
+	}
+
+	report := Check(entries, defaults)
+	if len(report.Bindings) != 6 {
+		t.Errorf("got %d bindings, want 6", len(report.Bindings))
+	}
+
+	want := []struct {
+		kind Kind
+		line int
+	}{
+		{Shadowed, 2},
+		{Duplicate, 4},
+		{OverridesDefault, 5},
+		{Invalid, 6},
+	}
+	if len(report.Conflicts) != len(want) {
+		t.Fatalf("got %d conflicts, want %d: %+v", len(report.Conflicts), len(want), report.Conflicts)
+	}
+	for i, w := range want {
+		c := report.Conflicts[i]
+		if c.Kind != w.kind || c.Binding.Line != w.line {
+			t.Errorf("conflict %d = %s at line %d, want %s at line %d", i, c.Kind, c.Binding.Line, w.kind, w.line)
+		}
+	}
+
+	if report.Warnings() != 3 {
+		t.Errorf("got %d warnings, want 3", report.Warnings())
+	}
+}
@@ -0,0 +1,9 @@
+package model
+
+// Action represents a Ghostty keybind action.
+type Action struct {
+	ID          int
+	Name        string
+	Parameters  string // e.g. "right, down, left, up, auto" or "<index>"
+	Description string
+}
@@ -0,0 +1,37 @@
+package model
+
+// ChangeKind is how an option changed between two Ghostty versions.
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota
+	ChangeRemoved
+	ChangeDescription
+)
+
+// String returns a short label for the kind.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+// Change is an option that differs between two Ghostty versions.
+type Change struct {
+	Kind ChangeKind
+	Old  *Config // nil for added options
+	New  *Config // nil for removed options
+}
+
+// Title returns the name of the changed option.
+func (c Change) Title() string {
+	if c.New != nil {
+		return c.New.Title
+	}
+	return c.Old.Title
+}
@@ -5,4 +5,10 @@ type Config struct {
 	ID          int
 	Title       string
 	Description string
+	Category    string
+	Platforms   []string // platforms the option is limited to, empty for all
+	Since       string   // Ghostty version the option was added in, if known
+	Type        string   // kind of value, inferred from the docs: bool, number, color, enum or string
+	GroupID     int      // group of options sharing one description, 0 for none
+	Snippet     string   // description around a search match, for description-only matches
 }
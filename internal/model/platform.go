@@ -0,0 +1,42 @@
+package model
+
+// Platforms an option can be limited to.
+const (
+	PlatformMacOS = "macos"
+	PlatformLinux = "linux"
+)
+
+// Platform returns the platform Ghostty runs as on a GOOS: the macOS app
+// on darwin and the GTK app everywhere else it builds.
+func Platform(goos string) string {
+	if goos == "darwin" {
+		return PlatformMacOS
+	}
+	return PlatformLinux
+}
+
+// PlatformLabel returns the display name of a platform.
+func PlatformLabel(platform string) string {
+	switch platform {
+	case PlatformMacOS:
+		return "macOS"
+	case PlatformLinux:
+		return "Linux (GTK)"
+	default:
+		return platform
+	}
+}
+
+// AppliesTo reports whether the option has an effect on the platform.
+// Options without platforms work everywhere.
+func (c Config) AppliesTo(platform string) bool {
+	if len(c.Platforms) == 0 {
+		return true
+	}
+	for _, p := range c.Platforms {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package model
+
+// Synonym is a phrase found in a search and the options it stands for.
+type Synonym struct {
+	Phrase string
+	Titles []string
+}
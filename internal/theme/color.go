@@ -0,0 +1,64 @@
+package theme
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Color is an RGB color.
+type Color struct {
+	R, G, B uint8
+}
+
+// Hex returns the color as "#rrggbb".
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// Mix blends c with other; t=0 returns c and t=1 returns other.
+func (c Color) Mix(other Color, t float64) Color {
+	blend := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+	}
+	return Color{blend(c.R, other.R), blend(c.G, other.G), blend(c.B, other.B)}
+}
+
+// x11Colors are the X11 color names most often used in configs.
+var x11Colors = map[string]Color{
+	"black":   {0x00, 0x00, 0x00},
+	"white":   {0xff, 0xff, 0xff},
+	"red":     {0xff, 0x00, 0x00},
+	"green":   {0x00, 0xff, 0x00},
+	"blue":    {0x00, 0x00, 0xff},
+	"yellow":  {0xff, 0xff, 0x00},
+	"cyan":    {0x00, 0xff, 0xff},
+	"magenta": {0xff, 0x00, 0xff},
+	"gray":    {0xbe, 0xbe, 0xbe},
+	"grey":    {0xbe, 0xbe, 0xbe},
+	"orange":  {0xff, 0xa5, 0x00},
+	"purple":  {0xa0, 0x20, 0xf0},
+}
+
+// ParseColor parses a hex color ("#RRGGBB", "RRGGBB", "#RGB") or one of
+// the common X11 color names.
+func ParseColor(s string) (Color, error) {
+	s = strings.TrimSpace(s)
+	if c, ok := x11Colors[strings.ToLower(s)]; ok {
+		return c, nil
+	}
+
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return Color{}, fmt.Errorf("invalid color %q", s)
+	}
+
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid color %q", s)
+	}
+	return Color{uint8(n >> 16), uint8(n >> 8), uint8(n)}, nil
+}
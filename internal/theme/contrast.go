@@ -0,0 +1,83 @@
+package theme
+
+import (
+	"fmt"
+	"math"
+)
+
+// WCAG contrast thresholds.
+const (
+	ContrastAALarge = 3.0 // AA for large text
+	ContrastAA      = 4.5 // AA for normal text
+	ContrastAAA     = 7.0 // AAA for normal text
+)
+
+// Luminance returns the WCAG relative luminance of the color, from 0 for
+// black to 1 for white.
+func (c Color) Luminance() float64 {
+	linear := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.04045 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+// ContrastRatio returns the WCAG contrast ratio between two colors, from
+// 1 (identical) to 21 (black on white).
+func ContrastRatio(a, b Color) float64 {
+	la, lb := a.Luminance(), b.Luminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// Pair is a foreground/background combination that text is drawn with.
+type Pair struct {
+	Name       string
+	Foreground Color
+	Background Color
+	Ratio      float64
+}
+
+// Passes reports whether the pair meets the given contrast ratio.
+func (p Pair) Passes(threshold float64) bool {
+	return p.Ratio >= threshold
+}
+
+// newPair creates a pair and computes its ratio.
+func newPair(name string, fg, bg Color) Pair {
+	return Pair{Name: name, Foreground: fg, Background: bg, Ratio: ContrastRatio(fg, bg)}
+}
+
+// Contrast returns the pairs of a color scheme that text is drawn with:
+// foreground, selection and cursor text on their backgrounds, then each
+// palette color on the background. Palette entries past 15 are only
+// included when the scheme sets them.
+func Contrast(c Colors) []Pair {
+	pairs := []Pair{
+		newPair("foreground", c.Foreground, c.Background),
+		newPair("selection", c.SelectionForeground, c.SelectionBackground),
+		newPair("cursor", c.CursorText, c.Cursor),
+	}
+	for i := 0; i < 256; i++ {
+		if i < 16 || c.PaletteSet[i] {
+			pairs = append(pairs, newPair(fmt.Sprintf("palette %d", i), c.Palette[i], c.Background))
+		}
+	}
+	return pairs
+}
+
+// Failing returns the pairs below the threshold.
+func Failing(pairs []Pair, threshold float64) []Pair {
+	var failing []Pair
+	for _, p := range pairs {
+		if !p.Passes(threshold) {
+			failing = append(failing, p)
+		}
+	}
+	return failing
+}
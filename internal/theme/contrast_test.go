@@ -0,0 +1,20 @@
+package theme
+
+import "testing"
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		a, b Color
+		want float64
+	}{
+		{Color{0, 0, 0}, Color{0xff, 0xff, 0xff}, 21},
+		{Color{0xff, 0xff, 0xff}, Color{0xff, 0xff, 0xff}, 1},
+		{Color{0x76, 0x76, 0x76}, Color{0xff, 0xff, 0xff}, 4.54},
+	}
+	for _, tt := range tests {
+		got := ContrastRatio(tt.a, tt.b)
+		if got < tt.want-0.01 || got > tt.want+0.01 {
+			t.Errorf("ContrastRatio(%s, %s) = %.2f, want %.2f", tt.a.Hex(), tt.b.Hex(), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package theme
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// exporters maps a format name to the function rendering it.
+var exporters = map[string]func(Colors) string{
+	"alacritty":    exportAlacritty,
+	"kitty":        exportKitty,
+	"wezterm-toml": exportWezterm,
+	"iterm2-plist": exportITerm2,
+	"xresources":   exportXresources,
+	"base16-yaml":  exportBase16,
+}
+
+// Formats returns the supported export formats.
+func Formats() []string {
+	formats := make([]string, 0, len(exporters))
+	for f := range exporters {
+		formats = append(formats, f)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Export renders colors in the given format.
+func Export(c Colors, format string) (string, error) {
+	export, ok := exporters[format]
+	if !ok {
+		return "", fmt.Errorf("unknown format %q (supported: %s)", format, strings.Join(Formats(), ", "))
+	}
+	return export(c), nil
+}
+
+func exportAlacritty(c Colors) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s, exported by ghofig\n\n", c.Name)
+
+	fmt.Fprintf(&b, "[colors.primary]\nbackground = %q\nforeground = %q\n\n", c.Background.Hex(), c.Foreground.Hex())
+	fmt.Fprintf(&b, "[colors.cursor]\ncursor = %q\ntext = %q\n\n", c.Cursor.Hex(), c.CursorText.Hex())
+	fmt.Fprintf(&b, "[colors.selection]\nbackground = %q\ntext = %q\n\n", c.SelectionBackground.Hex(), c.SelectionForeground.Hex())
+
+	names := []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+	b.WriteString("[colors.normal]\n")
+	for i, name := range names {
+		fmt.Fprintf(&b, "%s = %q\n", name, c.Palette[i].Hex())
+	}
+	b.WriteString("\n[colors.bright]\n")
+	for i, name := range names {
+		fmt.Fprintf(&b, "%s = %q\n", name, c.Palette[i+8].Hex())
+	}
+	return b.String()
+}
+
+func exportKitty(c Colors) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s, exported by ghofig\n\n", c.Name)
+
+	fmt.Fprintf(&b, "background %s\n", c.Background.Hex())
+	fmt.Fprintf(&b, "foreground %s\n", c.Foreground.Hex())
+	fmt.Fprintf(&b, "cursor %s\n", c.Cursor.Hex())
+	fmt.Fprintf(&b, "cursor_text_color %s\n", c.CursorText.Hex())
+	fmt.Fprintf(&b, "selection_background %s\n", c.SelectionBackground.Hex())
+	fmt.Fprintf(&b, "selection_foreground %s\n\n", c.SelectionForeground.Hex())
+
+	for i := 0; i < 16; i++ {
+		fmt.Fprintf(&b, "color%d %s\n", i, c.Palette[i].Hex())
+	}
+	return b.String()
+}
+
+func exportWezterm(c Colors) string {
+	quoted := func(colors []Color) string {
+		parts := make([]string, len(colors))
+		for i, color := range colors {
+			parts[i] = fmt.Sprintf("%q", color.Hex())
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	var b strings.Builder
+	b.WriteString("[colors]\n")
+	fmt.Fprintf(&b, "background = %q\n", c.Background.Hex())
+	fmt.Fprintf(&b, "foreground = %q\n", c.Foreground.Hex())
+	fmt.Fprintf(&b, "cursor_bg = %q\n", c.Cursor.Hex())
+	fmt.Fprintf(&b, "cursor_border = %q\n", c.Cursor.Hex())
+	fmt.Fprintf(&b, "cursor_fg = %q\n", c.CursorText.Hex())
+	fmt.Fprintf(&b, "selection_bg = %q\n", c.SelectionBackground.Hex())
+	fmt.Fprintf(&b, "selection_fg = %q\n", c.SelectionForeground.Hex())
+	fmt.Fprintf(&b, "ansi = [%s]\n", quoted(c.Palette[0:8]))
+	fmt.Fprintf(&b, "brights = [%s]\n", quoted(c.Palette[8:16]))
+	fmt.Fprintf(&b, "\n[metadata]\nname = %q\norigin_url = \"exported by ghofig\"\n", c.Name)
+	return b.String()
+}
+
+func exportITerm2(c Colors) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+`)
+
+	entry := func(name string, color Color) {
+		fmt.Fprintf(&b, "\t<key>%s</key>\n\t<dict>\n", name)
+		fmt.Fprintf(&b, "\t\t<key>Alpha Component</key>\n\t\t<real>1</real>\n")
+		fmt.Fprintf(&b, "\t\t<key>Blue Component</key>\n\t\t<real>%s</real>\n", component(color.B))
+		fmt.Fprintf(&b, "\t\t<key>Color Space</key>\n\t\t<string>sRGB</string>\n")
+		fmt.Fprintf(&b, "\t\t<key>Green Component</key>\n\t\t<real>%s</real>\n", component(color.G))
+		fmt.Fprintf(&b, "\t\t<key>Red Component</key>\n\t\t<real>%s</real>\n", component(color.R))
+		b.WriteString("\t</dict>\n")
+	}
+
+	// Keys are written in sorted order, the same way iTerm2 saves them
+	keys := map[string]Color{
+		"Background Color":    c.Background,
+		"Foreground Color":    c.Foreground,
+		"Bold Color":          c.Foreground,
+		"Cursor Color":        c.Cursor,
+		"Cursor Text Color":   c.CursorText,
+		"Selection Color":     c.SelectionBackground,
+		"Selected Text Color": c.SelectionForeground,
+	}
+	for i := 0; i < 16; i++ {
+		keys[fmt.Sprintf("Ansi %d Color", i)] = c.Palette[i]
+	}
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		entry(name, keys[name])
+	}
+
+	b.WriteString("</dict>\n</plist>\n")
+	return b.String()
+}
+
+// component formats an 8-bit channel as the 0-1 float iTerm2 uses.
+func component(v uint8) string {
+	return fmt.Sprintf("%.6f", float64(v)/255)
+}
+
+func exportXresources(c Colors) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "! %s, exported by ghofig\n\n", c.Name)
+
+	fmt.Fprintf(&b, "*.foreground: %s\n", c.Foreground.Hex())
+	fmt.Fprintf(&b, "*.background: %s\n", c.Background.Hex())
+	fmt.Fprintf(&b, "*.cursorColor: %s\n", c.Cursor.Hex())
+	for i := 0; i < 16; i++ {
+		fmt.Fprintf(&b, "*.color%d: %s\n", i, c.Palette[i].Hex())
+	}
+	return b.String()
+}
+
+// exportBase16 maps the scheme onto base16's 16 slots. Base16 shell
+// themes store base09, base0F and base01-06 in palette 16-21; those are
+// used when the scheme sets them, otherwise they are derived from the
+// ANSI colors.
+func exportBase16(c Colors) string {
+	extra := func(index int, fallback Color) Color {
+		if c.PaletteSet[index] {
+			return c.Palette[index]
+		}
+		return fallback
+	}
+
+	bases := [16]Color{
+		c.Background,
+		extra(18, c.Background.Mix(c.Foreground, 0.1)),
+		extra(19, c.SelectionBackground),
+		c.Palette[8],
+		extra(20, c.Palette[8].Mix(c.Foreground, 0.5)),
+		c.Foreground,
+		extra(21, c.Palette[7]),
+		c.Palette[15],
+		c.Palette[1],
+		extra(16, c.Palette[1].Mix(c.Palette[3], 0.5)),
+		c.Palette[3],
+		c.Palette[2],
+		c.Palette[6],
+		c.Palette[4],
+		c.Palette[5],
+		extra(17, c.Palette[9]),
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "scheme: %q\n", c.Name)
+	b.WriteString("author: \"exported by ghofig\"\n")
+	for i, color := range bases {
+		fmt.Fprintf(&b, "base%02X: %q\n", i, strings.TrimPrefix(color.Hex(), "#"))
+	}
+	return b.String()
+}
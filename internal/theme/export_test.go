@@ -0,0 +1,43 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	colors := Default()
+	colors.Name = "test"
+	colors.Background = Color{0x10, 0x20, 0x30}
+	colors.Palette[1] = Color{0xcc, 0x00, 0x00}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"alacritty", []string{"# test, exported by ghofig", "[colors.primary]\nbackground = \"#102030\"", "[colors.normal]\nblack = \"#1d1f21\"\nred = \"#cc0000\""}},
+		{"kitty", []string{"background #102030\n", "color1 #cc0000\n", "color15 #eaeaea\n"}},
+		{"wezterm-toml", []string{"[colors]\nbackground = \"#102030\"", "ansi = [\"#1d1f21\", \"#cc0000\",", "name = \"test\""}},
+		{"iterm2-plist", []string{"<key>Ansi 1 Color</key>\n\t<dict>", "<real>0.800000</real>", "<key>Background Color</key>"}},
+		{"xresources", []string{"! test, exported by ghofig", "*.background: #102030\n", "*.color1: #cc0000\n"}},
+		{"base16-yaml", []string{"scheme: \"test\"", "base00: \"102030\"", "base08: \"cc0000\""}},
+	}
+	for _, tt := range tests {
+		out, err := Export(colors, tt.format)
+		if err != nil {
+			t.Fatalf("Export(%s) failed: %v", tt.format, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out, want) {
+				t.Errorf("Export(%s) is missing %q:\n%s", tt.format, want, out)
+			}
+		}
+	}
+
+	if len(tests) != len(Formats()) {
+		t.Errorf("Tested %d formats, want all %d", len(tests), len(Formats()))
+	}
+	if _, err := Export(colors, "nope"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
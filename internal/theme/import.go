@@ -0,0 +1,395 @@
+package theme
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// importers maps a scheme format to its parser.
+var importers = map[string]func(io.Reader) (Colors, error){
+	"iterm2":     ParseITerm2,
+	"base16":     ParseBase16,
+	"xresources": ParseXresources,
+}
+
+// DetectFormat guesses a scheme's format from its file name.
+func DetectFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".itermcolors":
+		return "iterm2", nil
+	case ".yaml", ".yml":
+		return "base16", nil
+	case ".xresources", ".xdefaults", "":
+		return "xresources", nil
+	}
+
+	base := strings.ToLower(filepath.Base(path))
+	if strings.HasPrefix(base, ".xresources") || strings.HasPrefix(base, ".xdefaults") {
+		return "xresources", nil
+	}
+	return "", fmt.Errorf("can't tell the format of %s, use --format", path)
+}
+
+// Import parses a color scheme in the given format.
+func Import(r io.Reader, format string) (Colors, error) {
+	parse, ok := importers[format]
+	if !ok {
+		return Colors{}, fmt.Errorf("unknown format %q (supported: base16, iterm2, xresources)", format)
+	}
+	return parse(r)
+}
+
+// ThemeFile renders colors as a Ghostty theme file.
+func (c Colors) ThemeFile() string {
+	var b strings.Builder
+	for i := 0; i < 256; i++ {
+		if i < 16 || c.PaletteSet[i] {
+			fmt.Fprintf(&b, "palette = %d=%s\n", i, c.Palette[i].Hex())
+		}
+	}
+	fmt.Fprintf(&b, "background = %s\n", c.Background.Hex())
+	fmt.Fprintf(&b, "foreground = %s\n", c.Foreground.Hex())
+	fmt.Fprintf(&b, "cursor-color = %s\n", c.Cursor.Hex())
+	fmt.Fprintf(&b, "cursor-text = %s\n", c.CursorText.Hex())
+	fmt.Fprintf(&b, "selection-background = %s\n", c.SelectionBackground.Hex())
+	fmt.Fprintf(&b, "selection-foreground = %s\n", c.SelectionForeground.Hex())
+	return b.String()
+}
+
+// Install writes a theme file into the user's themes directory and
+// returns its path. An existing theme of the same name is only replaced
+// when force is set; otherwise the error wraps os.ErrExist.
+func Install(name string, c Colors, force bool) (string, error) {
+	dir, err := UserDir()
+	if err != nil {
+		return "", err
+	}
+	return writeTheme(dir, name, c, force)
+}
+
+// writeTheme writes a theme file into dir.
+func writeTheme(dir, name string, c Colors, force bool) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, name)
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !force {
+		flags |= os.O_EXCL
+	}
+	file, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		if os.IsExist(err) {
+			return "", fmt.Errorf("theme %s already exists: %w", path, os.ErrExist)
+		}
+		return "", err
+	}
+	_, err = file.WriteString(c.ThemeFile())
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// plistDict is a <dict> in an XML property list.
+type plistDict struct {
+	Keys   []string
+	Values []plistValue
+}
+
+// plistValue is any value in a property list.
+type plistValue struct {
+	Text string
+	Dict *plistDict
+}
+
+// ParseITerm2 reads an iTerm2 .itermcolors property list.
+func ParseITerm2(r io.Reader) (Colors, error) {
+	decoder := xml.NewDecoder(r)
+
+	var root *plistDict
+	for root == nil {
+		tok, err := decoder.Token()
+		if err != nil {
+			return Colors{}, fmt.Errorf("invalid itermcolors file: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == "dict" {
+			root, err = readPlistDict(decoder)
+			if err != nil {
+				return Colors{}, fmt.Errorf("invalid itermcolors file: %w", err)
+			}
+		}
+	}
+
+	colors := Default()
+	found := map[string]bool{}
+	for i, key := range root.Keys {
+		dict := root.Values[i].Dict
+		if dict == nil {
+			continue
+		}
+		color := plistColor(dict)
+		found[key] = true
+
+		switch key {
+		case "Background Color":
+			colors.Background = color
+		case "Foreground Color":
+			colors.Foreground = color
+		case "Cursor Color":
+			colors.Cursor = color
+		case "Cursor Text Color":
+			colors.CursorText = color
+		case "Selection Color":
+			colors.SelectionBackground = color
+		case "Selected Text Color":
+			colors.SelectionForeground = color
+		default:
+			var n int
+			if _, err := fmt.Sscanf(key, "Ansi %d Color", &n); err == nil && n >= 0 && n < 16 {
+				colors.Palette[n] = color
+				colors.PaletteSet[n] = true
+			}
+		}
+	}
+
+	if !found["Background Color"] || !found["Foreground Color"] {
+		return Colors{}, fmt.Errorf("invalid itermcolors file: missing background or foreground color")
+	}
+	if !found["Cursor Color"] {
+		colors.Cursor = colors.Foreground
+	}
+	if !found["Cursor Text Color"] {
+		colors.CursorText = colors.Background
+	}
+	if !found["Selection Color"] {
+		colors.SelectionBackground = colors.Foreground
+	}
+	if !found["Selected Text Color"] {
+		colors.SelectionForeground = colors.Background
+	}
+	return colors, nil
+}
+
+// readPlistDict reads a <dict> whose start element was just consumed.
+func readPlistDict(decoder *xml.Decoder) (*plistDict, error) {
+	dict := &plistDict{}
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "key":
+				var key string
+				if err := decoder.DecodeElement(&key, &t); err != nil {
+					return nil, err
+				}
+				dict.Keys = append(dict.Keys, key)
+			case "dict":
+				nested, err := readPlistDict(decoder)
+				if err != nil {
+					return nil, err
+				}
+				dict.Values = append(dict.Values, plistValue{Dict: nested})
+			default:
+				var text string
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, err
+				}
+				dict.Values = append(dict.Values, plistValue{Text: text})
+			}
+		case xml.EndElement:
+			if t.Name.Local == "dict" {
+				if len(dict.Keys) != len(dict.Values) {
+					return nil, fmt.Errorf("dict has %d keys but %d values", len(dict.Keys), len(dict.Values))
+				}
+				return dict, nil
+			}
+		}
+	}
+}
+
+// plistColor reads an iTerm2 color dict with 0-1 float components.
+func plistColor(dict *plistDict) Color {
+	channel := func(name string) uint8 {
+		for i, key := range dict.Keys {
+			if key == name {
+				v, _ := strconv.ParseFloat(strings.TrimSpace(dict.Values[i].Text), 64)
+				return uint8(min(max(v, 0), 1)*255 + 0.5)
+			}
+		}
+		return 0
+	}
+	return Color{channel("Red Component"), channel("Green Component"), channel("Blue Component")}
+}
+
+// base16Palette is the standard base16 to ANSI mapping. Palette 16-21 hold
+// the extra base16 colors, as base16-shell does.
+var base16Palette = map[int]string{
+	0: "base00", 1: "base08", 2: "base0B", 3: "base0A",
+	4: "base0D", 5: "base0E", 6: "base0C", 7: "base05",
+	8: "base03", 9: "base08", 10: "base0B", 11: "base0A",
+	12: "base0D", 13: "base0E", 14: "base0C", 15: "base07",
+	16: "base09", 17: "base0F", 18: "base01", 19: "base02",
+	20: "base04", 21: "base06",
+}
+
+// base24Brights are the base24 overrides for the bright ANSI colors.
+var base24Brights = map[int]string{
+	8: "base02", 9: "base12", 10: "base14", 11: "base13",
+	12: "base16", 13: "base17", 14: "base15",
+}
+
+// ParseBase16 reads a base16 or base24 YAML scheme, in either the
+// classic flat layout or the newer layout with a `palette` map.
+func ParseBase16(r io.Reader) (Colors, error) {
+	var doc map[string]any
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return Colors{}, fmt.Errorf("invalid base16 scheme: %w", err)
+	}
+
+	values := doc
+	if palette, ok := doc["palette"].(map[string]any); ok {
+		values = palette
+	}
+
+	bases := map[string]Color{}
+	for key, v := range values {
+		s, ok := v.(string)
+		if !ok || !strings.HasPrefix(strings.ToLower(key), "base") {
+			continue
+		}
+		color, err := ParseColor(s)
+		if err != nil {
+			return Colors{}, fmt.Errorf("invalid base16 scheme: %s: %w", key, err)
+		}
+		// Normalize "base0a" to "base0A"
+		bases["base"+strings.ToUpper(key[4:])] = color
+	}
+
+	for _, key := range []string{"base00", "base05"} {
+		if _, ok := bases[key]; !ok {
+			return Colors{}, fmt.Errorf("invalid base16 scheme: missing %s", key)
+		}
+	}
+
+	colors := Default()
+	for i, key := range base16Palette {
+		if color, ok := bases[key]; ok {
+			colors.Palette[i] = color
+			colors.PaletteSet[i] = true
+		}
+	}
+	if _, ok := bases["base12"]; ok {
+		for i, key := range base24Brights {
+			if color, ok := bases[key]; ok {
+				colors.Palette[i] = color
+			}
+		}
+	}
+
+	colors.Background = bases["base00"]
+	colors.Foreground = bases["base05"]
+	colors.Cursor = bases["base05"]
+	colors.CursorText = bases["base00"]
+	colors.SelectionBackground = colors.Foreground
+	if selection, ok := bases["base02"]; ok {
+		colors.SelectionBackground = selection
+	}
+	colors.SelectionForeground = bases["base05"]
+
+	for _, key := range []string{"scheme", "name"} {
+		if name, ok := doc[key].(string); ok {
+			colors.Name = name
+		}
+	}
+	return colors, nil
+}
+
+// xresourcesPattern matches lines like "*.color0: #000000" or
+// "URxvt*background: #fff".
+var xresourcesPattern = regexp.MustCompile(`^[\w.*-]*[.*](\w+)\s*:\s*(\S+)`)
+
+// definePattern matches C preprocessor defines like "#define bg #000000".
+var definePattern = regexp.MustCompile(`^#define\s+(\S+)\s+(\S+)`)
+
+// ParseXresources reads color resources from an Xresources file,
+// expanding simple #define macros.
+func ParseXresources(r io.Reader) (Colors, error) {
+	colors := Default()
+	defines := map[string]string{}
+	found := map[string]bool{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		if match := definePattern.FindStringSubmatch(line); match != nil {
+			defines[match[1]] = match[2]
+			continue
+		}
+
+		match := xresourcesPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		name, value := match[1], match[2]
+		if v, ok := defines[value]; ok {
+			value = v
+		}
+
+		color, err := ParseColor(value)
+		if err != nil {
+			continue // Not a color resource
+		}
+		found[name] = true
+
+		switch name {
+		case "background":
+			colors.Background = color
+		case "foreground":
+			colors.Foreground = color
+		case "cursorColor":
+			colors.Cursor = color
+		default:
+			if n, err := strconv.Atoi(strings.TrimPrefix(name, "color")); err == nil && strings.HasPrefix(name, "color") && n >= 0 && n < 256 {
+				colors.Palette[n] = color
+				colors.PaletteSet[n] = true
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Colors{}, err
+	}
+
+	if !found["background"] || !found["foreground"] {
+		return Colors{}, fmt.Errorf("invalid Xresources file: missing background or foreground")
+	}
+	if !found["cursorColor"] {
+		colors.Cursor = colors.Foreground
+	}
+	colors.CursorText = colors.Background
+	colors.SelectionBackground = colors.Foreground
+	colors.SelectionForeground = colors.Background
+	return colors, nil
+}
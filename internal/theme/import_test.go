@@ -0,0 +1,132 @@
+package theme
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseBase16(t *testing.T) {
+	scheme := `
+scheme: "Test"
+base00: "181818"
+base02: "383838"
+base05: "d8d8d8"
+base08: "ab4642"
+base0B: "a1b56c"
+base09: "dc9656"
+`
+	colors, err := ParseBase16(strings.NewReader(scheme))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := map[string]Color{
+		"background": colors.Background,
+		"foreground": colors.Foreground,
+		"selection":  colors.SelectionBackground,
+		"palette 1":  colors.Palette[1],
+		"palette 10": colors.Palette[10],
+		"palette 16": colors.Palette[16],
+	}
+	want := map[string]string{
+		"background": "#181818",
+		"foreground": "#d8d8d8",
+		"selection":  "#383838",
+		"palette 1":  "#ab4642",
+		"palette 10": "#a1b56c",
+		"palette 16": "#dc9656",
+	}
+	for name, color := range checks {
+		if color.Hex() != want[name] {
+			t.Errorf("%s = %s, want %s", name, color.Hex(), want[name])
+		}
+	}
+	if colors.Name != "Test" {
+		t.Errorf("Name = %q, want %q", colors.Name, "Test")
+	}
+}
+
+func TestParseITerm2(t *testing.T) {
+	plist := `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>Ansi 1 Color</key>
+	<dict>
+		<key>Blue Component</key><real>0</real>
+		<key>Green Component</key><real>0</real>
+		<key>Red Component</key><real>1</real>
+	</dict>
+	<key>Background Color</key>
+	<dict>
+		<key>Blue Component</key><real>0.2</real>
+		<key>Green Component</key><real>0.2</real>
+		<key>Red Component</key><real>0.2</real>
+	</dict>
+	<key>Foreground Color</key>
+	<dict>
+		<key>Blue Component</key><real>1</real>
+		<key>Green Component</key><real>1</real>
+		<key>Red Component</key><real>1</real>
+	</dict>
+</dict>
+</plist>`
+	colors, err := ParseITerm2(strings.NewReader(plist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := colors.Palette[1].Hex(); got != "#ff0000" {
+		t.Errorf("palette 1 = %s, want #ff0000", got)
+	}
+	if got := colors.Background.Hex(); got != "#333333" {
+		t.Errorf("background = %s, want #333333", got)
+	}
+	if got := colors.CursorText.Hex(); got != "#333333" {
+		t.Errorf("cursor-text = %s, want #333333", got)
+	}
+}
+
+func TestParseXresources(t *testing.T) {
+	xres := `! comment
+#define bg #101010
+*.background: bg
+*.foreground: #eeeeee
+URxvt*color4: #0000aa
+`
+	colors, err := ParseXresources(strings.NewReader(xres))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := colors.Background.Hex(); got != "#101010" {
+		t.Errorf("background = %s, want #101010", got)
+	}
+	if got := colors.Palette[4].Hex(); got != "#0000aa" {
+		t.Errorf("palette 4 = %s, want #0000aa", got)
+	}
+}
+
+func TestWriteTheme(t *testing.T) {
+	dir := t.TempDir()
+	colors := Default()
+
+	path, err := writeTheme(dir, "mine", colors, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	colors.Background = Color{0x12, 0x34, 0x56}
+	if _, err := writeTheme(dir, "mine", colors, false); !errors.Is(err, os.ErrExist) {
+		t.Errorf("Expected an existing theme error, got %v", err)
+	}
+	if data, _ := os.ReadFile(path); strings.Contains(string(data), "#123456") {
+		t.Error("Existing theme was replaced without force")
+	}
+
+	if _, err := writeTheme(dir, "mine", colors, true); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(path); !strings.Contains(string(data), "background = #123456") {
+		t.Errorf("Theme wasn't replaced with force:\n%s", data)
+	}
+}
@@ -0,0 +1,251 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/intaek-h/ghofig/internal/config"
+)
+
+// Colors is a resolved color scheme.
+type Colors struct {
+	Name                string
+	Background          Color
+	Foreground          Color
+	Cursor              Color
+	CursorText          Color
+	SelectionBackground Color
+	SelectionForeground Color
+	Palette             [256]Color
+	PaletteSet          [256]bool // true for entries set by a theme or the config
+}
+
+// defaultPalette is Ghostty's default 16 color palette.
+var defaultPalette = [16]Color{
+	{0x1d, 0x1f, 0x21}, {0xcc, 0x66, 0x66}, {0xb5, 0xbd, 0x68}, {0xf0, 0xc6, 0x74},
+	{0x81, 0xa2, 0xbe}, {0xb2, 0x94, 0xbb}, {0x8a, 0xbe, 0xb7}, {0xc5, 0xc8, 0xc6},
+	{0x66, 0x66, 0x66}, {0xd5, 0x4e, 0x53}, {0xb9, 0xca, 0x4a}, {0xe7, 0xc5, 0x47},
+	{0x7a, 0xa6, 0xda}, {0xc3, 0x97, 0xd8}, {0x70, 0xc0, 0xb1}, {0xea, 0xea, 0xea},
+}
+
+// Default returns Ghostty's default colors.
+func Default() Colors {
+	c := Colors{
+		Name:       "ghostty",
+		Background: Color{0x28, 0x2c, 0x34},
+		Foreground: Color{0xff, 0xff, 0xff},
+	}
+
+	copy(c.Palette[:16], defaultPalette[:])
+
+	// 6x6x6 color cube
+	levels := []uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+	for i := 0; i < 216; i++ {
+		c.Palette[16+i] = Color{levels[i/36], levels[(i/6)%6], levels[i%6]}
+	}
+
+	// Grayscale ramp
+	for i := 0; i < 24; i++ {
+		v := uint8(8 + i*10)
+		c.Palette[232+i] = Color{v, v, v}
+	}
+
+	c.Cursor = c.Foreground
+	c.CursorText = c.Background
+	c.SelectionBackground = c.Foreground
+	c.SelectionForeground = c.Background
+	return c
+}
+
+// colorKeys are the config options that affect the color scheme.
+var colorKeys = map[string]bool{
+	"background":           true,
+	"foreground":           true,
+	"cursor-color":         true,
+	"cursor-text":          true,
+	"selection-background": true,
+	"selection-foreground": true,
+	"palette":              true,
+}
+
+// Resolve computes the effective colors of a config: Ghostty's defaults,
+// then the theme, then any color options set directly in the config.
+// dark selects the dark variant of a `light:x,dark:y` theme.
+func Resolve(entries []config.Entry, dark bool) (Colors, error) {
+	colors := Default()
+	explicit := map[string]string{}
+
+	themeName := ""
+	for _, e := range entries {
+		if e.Key == "theme" {
+			themeName = e.Value
+		}
+	}
+
+	if themeName != "" {
+		themeName = pickVariant(themeName, dark)
+		path, err := FindTheme(themeName)
+		if err != nil {
+			return colors, err
+		}
+
+		themeEntries, err := config.LoadEntriesFrom(path)
+		if err != nil {
+			return colors, err
+		}
+		if err := colors.apply(themeEntries, explicit); err != nil {
+			return colors, err
+		}
+		colors.Name = filepath.Base(themeName)
+	}
+
+	if err := colors.apply(entries, explicit); err != nil {
+		return colors, err
+	}
+
+	// Unset cursor and selection colors follow the final fg/bg, and so do
+	// cell-foreground/cell-background, which for a static scheme means
+	// the default cell colors
+	colors.Cursor = colors.follow(explicit["cursor-color"], colors.Cursor, colors.Foreground)
+	colors.CursorText = colors.follow(explicit["cursor-text"], colors.CursorText, colors.Background)
+	colors.SelectionBackground = colors.follow(explicit["selection-background"], colors.SelectionBackground, colors.Foreground)
+	colors.SelectionForeground = colors.follow(explicit["selection-foreground"], colors.SelectionForeground, colors.Background)
+
+	return colors, nil
+}
+
+// follow resolves a cursor or selection color from the value it was set
+// to, falling back to fallback when it wasn't set.
+func (c Colors) follow(value string, current, fallback Color) Color {
+	switch value {
+	case "":
+		return fallback
+	case "cell-foreground":
+		return c.Foreground
+	case "cell-background":
+		return c.Background
+	default:
+		return current
+	}
+}
+
+// apply sets colors from config entries, recording the value each key
+// was set to.
+func (c *Colors) apply(entries []config.Entry, explicit map[string]string) error {
+	for _, e := range entries {
+		if !colorKeys[e.Key] || e.Value == "" {
+			continue
+		}
+
+		if e.Key == "palette" {
+			index, value, ok := strings.Cut(e.Value, "=")
+			if !ok {
+				return fmt.Errorf("%s: invalid palette entry %q", e.Location(), e.Value)
+			}
+			n, err := strconv.ParseInt(strings.TrimSpace(index), 0, 0)
+			if err != nil || n < 0 || n > 255 {
+				return fmt.Errorf("%s: invalid palette index %q", e.Location(), index)
+			}
+			color, err := ParseColor(value)
+			if err != nil {
+				return fmt.Errorf("%s: %w", e.Location(), err)
+			}
+			c.Palette[n] = color
+			c.PaletteSet[n] = true
+			continue
+		}
+
+		explicit[e.Key] = e.Value
+		if e.Value == "cell-foreground" || e.Value == "cell-background" {
+			continue // Resolved once fg/bg are final
+		}
+
+		color, err := ParseColor(e.Value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Location(), err)
+		}
+
+		switch e.Key {
+		case "background":
+			c.Background = color
+		case "foreground":
+			c.Foreground = color
+		case "cursor-color":
+			c.Cursor = color
+		case "cursor-text":
+			c.CursorText = color
+		case "selection-background":
+			c.SelectionBackground = color
+		case "selection-foreground":
+			c.SelectionForeground = color
+		}
+	}
+	return nil
+}
+
+// pickVariant chooses between the variants of "light:x,dark:y".
+func pickVariant(theme string, dark bool) string {
+	if !strings.Contains(theme, ":") || filepath.IsAbs(theme) {
+		return theme
+	}
+
+	want := "light:"
+	if dark {
+		want = "dark:"
+	}
+	for _, part := range strings.Split(theme, ",") {
+		part = strings.TrimSpace(part)
+		if name, ok := strings.CutPrefix(part, want); ok {
+			return name
+		}
+	}
+	return theme
+}
+
+// Dirs returns the directories Ghostty looks for themes in, in priority
+// order: the user's themes directory, then the bundled themes.
+func Dirs() []string {
+	var dirs []string
+
+	if userDir, err := UserDir(); err == nil {
+		dirs = append(dirs, userDir)
+	}
+
+	if resources := os.Getenv("GHOSTTY_RESOURCES_DIR"); resources != "" {
+		dirs = append(dirs, filepath.Join(resources, "themes"))
+	}
+
+	dirs = append(dirs,
+		"/Applications/Ghostty.app/Contents/Resources/ghostty/themes",
+		"/usr/share/ghostty/themes",
+		"/usr/local/share/ghostty/themes",
+	)
+	return dirs
+}
+
+// UserDir returns the user's themes directory.
+func UserDir() (string, error) {
+	configPath, err := config.GetConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "themes"), nil
+}
+
+// FindTheme returns the path of a theme by name or absolute path.
+func FindTheme(name string) (string, error) {
+	if filepath.IsAbs(name) {
+		return name, nil
+	}
+
+	for _, dir := range Dirs() {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("theme %q not found", name)
+}
@@ -0,0 +1,78 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/intaek-h/ghofig/internal/config"
+)
+
+func TestResolve(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"day":   "background = #ffffff\nforeground = #000000\npalette = 1=#aa0000\n",
+		"night": "background = #101010\nforeground = #e0e0e0\npalette = 1=#ff5555\ncursor-color = #ffcc00\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	input := "theme = light:" + filepath.Join(dir, "day") + ",dark:" + filepath.Join(dir, "night") + "\n" +
+		"palette = 2=#00bb00\n" +
+		"foreground = #c0c0c0\n" +
+		"selection-background = cell-foreground\n"
+	entries, err := config.Parse(strings.NewReader(input), "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	colors, err := Resolve(entries, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checks := []struct {
+		name string
+		got  Color
+		want string
+	}{
+		{"background from the dark theme", colors.Background, "#101010"},
+		{"foreground from the config", colors.Foreground, "#c0c0c0"},
+		{"palette 1 from the theme", colors.Palette[1], "#ff5555"},
+		{"palette 2 from the config", colors.Palette[2], "#00bb00"},
+		{"palette 3 by default", colors.Palette[3], "#f0c674"},
+		{"palette 196 from the color cube", colors.Palette[196], "#ff0000"},
+		{"cursor from the theme", colors.Cursor, "#ffcc00"},
+		{"cursor text follows background", colors.CursorText, "#101010"},
+		{"selection follows the final foreground", colors.SelectionBackground, "#c0c0c0"},
+	}
+	for _, c := range checks {
+		if c.got.Hex() != c.want {
+			t.Errorf("%s = %s, want %s", c.name, c.got.Hex(), c.want)
+		}
+	}
+	if colors.Name != "night" {
+		t.Errorf("Name = %q, want night", colors.Name)
+	}
+	if !colors.PaletteSet[1] || !colors.PaletteSet[2] || colors.PaletteSet[3] {
+		t.Error("Expected only palette entries set by the theme or config to be marked set")
+	}
+
+	light, err := Resolve(entries, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := light.Background.Hex(); got != "#ffffff" {
+		t.Errorf("light background = %s, want #ffffff", got)
+	}
+
+	bad, err := config.Parse(strings.NewReader("palette = 300=#000000\n"), "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Resolve(bad, true); err == nil {
+		t.Error("Expected an error for an out of range palette index")
+	}
+}
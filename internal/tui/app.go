@@ -1,10 +1,23 @@
 package tui
 
 import (
+	"fmt"
+
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/intaek-h/ghofig/internal/db"
+	"github.com/intaek-h/ghofig/internal/ghostty"
 )
 
+// ghosttyVersion is the Ghostty version options are checked against. The
+// zero version means unknown, and no option is flagged as too new.
+var ghosttyVersion ghostty.Version
+
+// SetGhosttyVersion sets the Ghostty version options are checked against.
+func SetGhosttyVersion(v ghostty.Version) {
+	ghosttyVersion = v
+}
+
 // View represents the current view state.
 type View int
 
@@ -13,6 +26,11 @@ const (
 	SearchView
 	DetailView
 	EditorView
+	KeybindView
+	ContrastView
+	MyConfigView
+	CategoryView
+	ChangesView
 )
 
 // KeyMap defines the keybindings for the app.
@@ -46,6 +64,11 @@ type Model struct {
 	search         SearchModel
 	detail         DetailModel
 	editor         EditorModel
+	keybinds       KeybindModel
+	contrast       ContrastModel
+	myConfig       MyConfigModel
+	categories     CategoryModel
+	changes        ChangesModel
 	selectedConfig int // ID of selected config for detail view
 }
 
@@ -58,6 +81,11 @@ func New() Model {
 		search:      NewSearchModel(),
 		detail:      NewDetailModel(),
 		editor:      NewEditorModel(),
+		keybinds:    NewKeybindModel(),
+		contrast:    NewContrastModel(),
+		myConfig:    NewMyConfigModel(),
+		categories:  NewCategoryModel(),
+		changes:     NewChangesModel(),
 	}
 }
 
@@ -74,8 +102,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if key.Matches(msg, m.keys.Quit) {
 			if m.currentView == SearchView && m.search.IsInputFocused() {
 				// Don't quit while typing in search, let search handle it
-			} else if m.currentView == DetailView && m.detail.IsEditing() {
-				// Don't quit while editing, let detail handle it
+			} else if m.currentView == DetailView && m.detail.IsInputFocused() {
+				// Don't quit while editing or finding, let detail handle it
 			} else {
 				return m, tea.Quit
 			}
@@ -88,6 +116,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.search = m.search.SetSize(msg.Width, msg.Height)
 		m.detail = m.detail.SetSize(msg.Width, msg.Height)
 		m.editor = m.editor.SetSize(msg.Width, msg.Height)
+		m.keybinds = m.keybinds.SetSize(msg.Width, msg.Height)
+		m.contrast = m.contrast.SetSize(msg.Width, msg.Height)
+		m.myConfig = m.myConfig.SetSize(msg.Width, msg.Height)
+		m.categories = m.categories.SetSize(msg.Width, msg.Height)
+		m.changes = m.changes.SetSize(msg.Width, msg.Height)
 	}
 
 	// Route to current view
@@ -101,6 +134,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m, cmd = m.updateDetail(msg)
 	case EditorView:
 		m, cmd = m.updateEditor(msg)
+	case KeybindView:
+		m, cmd = m.updateKeybinds(msg)
+	case ContrastView:
+		m, cmd = m.updateContrast(msg)
+	case MyConfigView:
+		m, cmd = m.updateMyConfig(msg)
+	case CategoryView:
+		m, cmd = m.updateCategories(msg)
+	case ChangesView:
+		m, cmd = m.updateChanges(msg)
 	}
 
 	return m, cmd
@@ -117,6 +160,16 @@ func (m Model) View() string {
 		return m.detail.View()
 	case EditorView:
 		return m.editor.View()
+	case KeybindView:
+		return m.keybinds.View()
+	case ContrastView:
+		return m.contrast.View()
+	case MyConfigView:
+		return m.myConfig.View()
+	case CategoryView:
+		return m.categories.View()
+	case ChangesView:
+		return m.changes.View()
 	default:
 		return "Unknown view"
 	}
@@ -136,10 +189,30 @@ func (m Model) updateMenu(msg tea.Msg) (Model, tea.Cmd) {
 			case MenuItemConfigOptions:
 				m.currentView = SearchView
 				return m, m.search.Init()
+			case MenuItemCategories:
+				m.currentView = CategoryView
+				m.categories = m.categories.SetSize(m.width, m.height)
+				return m, m.categories.Init()
+			case MenuItemMyConfig:
+				m.currentView = MyConfigView
+				m.myConfig = m.myConfig.SetSize(m.width, m.height)
+				return m, m.myConfig.Init()
 			case MenuItemConfigEditor:
 				m.currentView = EditorView
 				m.editor = m.editor.SetSize(m.width, m.height)
 				return m, m.editor.Init()
+			case MenuItemKeybinds:
+				m.currentView = KeybindView
+				m.keybinds = m.keybinds.SetSize(m.width, m.height)
+				return m, m.keybinds.Init()
+			case MenuItemChanges:
+				m.currentView = ChangesView
+				m.changes = m.changes.SetSize(m.width, m.height)
+				return m, m.changes.Init()
+			case MenuItemContrast:
+				m.currentView = ContrastView
+				m.contrast = m.contrast.SetSize(m.width, m.height)
+				return m, m.contrast.Init()
 			}
 		}
 	}
@@ -156,8 +229,7 @@ func (m Model) updateSearch(msg tea.Msg) (Model, tea.Cmd) {
 		switch msg.String() {
 		case "esc":
 			// Go back to menu
-			m.search = NewSearchModel() // Reset search
-			m.search = m.search.SetSize(m.width, m.height)
+			m.search = m.search.Reset()
 			m.currentView = MenuView
 			return m, nil
 
@@ -171,6 +243,12 @@ func (m Model) updateSearch(msg tea.Msg) (Model, tea.Cmd) {
 					m.currentView = DetailView
 					return m, nil
 				}
+				if action := m.search.SelectedAction(); action != nil {
+					m.detail = m.detail.SetAction(action)
+					m.previousView = SearchView
+					m.currentView = DetailView
+					return m, nil
+				}
 			}
 		}
 	}
@@ -184,9 +262,19 @@ func (m Model) updateSearch(msg tea.Msg) (Model, tea.Cmd) {
 func (m Model) updateDetail(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		// Back to search (but not while editing)
-		if key.Matches(msg, m.keys.Back) && !m.detail.IsEditing() {
-			m.currentView = SearchView
+		// Back to where the option was opened from (but not while editing
+		// or finding)
+		if key.Matches(msg, m.keys.Back) && !m.detail.IsInputFocused() {
+			// Retrace followed links first
+			if m.detail.CanGoBack() {
+				m.detail = m.detail.GoBack()
+				return m, nil
+			}
+			m.currentView = m.previousView
+			if m.currentView == MyConfigView {
+				// The option may have been edited
+				return m, m.myConfig.Init()
+			}
 			return m, nil
 		}
 	}
@@ -212,3 +300,131 @@ func (m Model) updateEditor(msg tea.Msg) (Model, tea.Cmd) {
 	m.editor, cmd = m.editor.Update(msg)
 	return m, cmd
 }
+
+// updateKeybinds handles updates for the keybind view.
+func (m Model) updateKeybinds(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.Back) {
+			m.keybinds = NewKeybindModel() // Reset keybinds
+			m.keybinds = m.keybinds.SetSize(m.width, m.height)
+			m.currentView = MenuView
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.keybinds, cmd = m.keybinds.Update(msg)
+	return m, cmd
+}
+
+// updateContrast handles updates for the contrast view.
+func (m Model) updateContrast(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.Back) {
+			m.contrast = NewContrastModel() // Reset contrast
+			m.contrast = m.contrast.SetSize(m.width, m.height)
+			m.currentView = MenuView
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.contrast, cmd = m.contrast.Update(msg)
+	return m, cmd
+}
+
+// updateMyConfig handles updates for the config listing view.
+func (m Model) updateMyConfig(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			m.myConfig = NewMyConfigModel() // Reset listing
+			m.myConfig = m.myConfig.SetSize(m.width, m.height)
+			m.currentView = MenuView
+			return m, nil
+
+		case msg.String() == "enter":
+			entry := m.myConfig.Selected()
+			if entry == nil {
+				return m, nil
+			}
+			cfg, err := db.GetByTitle(entry.Key)
+			if err != nil {
+				m.myConfig = m.myConfig.SetMessage(fmt.Sprintf("Unknown option: %s", entry.Key))
+				return m, nil
+			}
+			m.selectedConfig = cfg.ID
+			m.detail = m.detail.SetConfig(cfg)
+			m.previousView = MyConfigView
+			m.currentView = DetailView
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.myConfig, cmd = m.myConfig.Update(msg)
+	return m, cmd
+}
+
+// updateCategories handles updates for the category tree view.
+func (m Model) updateCategories(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			m.categories = NewCategoryModel() // Reset tree
+			m.categories = m.categories.SetSize(m.width, m.height)
+			m.currentView = MenuView
+			return m, nil
+
+		case msg.String() == "enter":
+			if selected := m.categories.SelectedConfig(); selected != nil {
+				m.selectedConfig = selected.ID
+				m.detail = m.detail.SetConfig(selected)
+				m.previousView = CategoryView
+				m.currentView = DetailView
+				return m, nil
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.categories, cmd = m.categories.Update(msg)
+	return m, cmd
+}
+
+// updateChanges handles updates for the version changes view.
+func (m Model) updateChanges(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			m.changes = NewChangesModel() // Reset changes
+			m.changes = m.changes.SetSize(m.width, m.height)
+			m.currentView = MenuView
+			return m, nil
+
+		case msg.String() == "enter":
+			change := m.changes.Selected()
+			if change == nil {
+				return m, nil
+			}
+			cfg := change.New
+			if cfg == nil {
+				cfg = change.Old
+			}
+			m.selectedConfig = cfg.ID
+			m.detail = m.detail.SetConfig(cfg).SetNotes(m.changes.Notes())
+			m.previousView = ChangesView
+			m.currentView = DetailView
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.changes, cmd = m.changes.Update(msg)
+	return m, cmd
+}
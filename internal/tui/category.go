@@ -0,0 +1,272 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/intaek-h/ghofig/internal/db"
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+var (
+	categoryTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(ThemePrimary)
+
+	categoryCountStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	categoryItemStyle = lipgloss.NewStyle().
+				PaddingLeft(2)
+
+	categorySelectedStyle = lipgloss.NewStyle().
+				Foreground(ThemePrimary)
+
+	categoryHeaderStyle = lipgloss.NewStyle().
+				Bold(true)
+
+	categoryErrorStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
+
+	categoryHelpStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+)
+
+// categoryGroup is a category and the options in it.
+type categoryGroup struct {
+	name    string
+	configs []model.Config
+}
+
+// categoryRow is a visible row of the tree: a category header, or an
+// option inside an expanded category.
+type categoryRow struct {
+	group  int
+	config int // index into the group's configs, -1 for the header
+}
+
+// categoriesMsg carries every option ordered by category.
+type categoriesMsg struct {
+	configs []model.Config
+	err     error
+}
+
+// CategoryModel shows options as a tree of collapsible categories.
+type CategoryModel struct {
+	groups   []categoryGroup
+	all      []model.Config // every option, before platform filtering
+	expanded map[int]bool
+	// allPlatforms shows options that don't apply to this platform
+	allPlatforms bool
+	cursor       int
+	width        int
+	height       int
+	err          error
+}
+
+// NewCategoryModel creates a new category model.
+func NewCategoryModel() CategoryModel {
+	return CategoryModel{expanded: map[int]bool{}}
+}
+
+// SetSize updates dimensions.
+func (m CategoryModel) SetSize(width, height int) CategoryModel {
+	m.width = width
+	m.height = height
+	return m
+}
+
+// Init loads the options grouped by category.
+func (m CategoryModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		configs, err := db.GetByCategory()
+		return categoriesMsg{configs: configs, err: err}
+	}
+}
+
+// group builds the categories from the options that are shown.
+// Categories left empty by platform filtering are dropped.
+func (m CategoryModel) group() CategoryModel {
+	var groups []categoryGroup
+	for _, c := range m.all {
+		if !m.allPlatforms && !c.AppliesTo(currentPlatform) {
+			continue
+		}
+		if len(groups) == 0 || groups[len(groups)-1].name != c.Category {
+			groups = append(groups, categoryGroup{name: c.Category})
+		}
+		last := &groups[len(groups)-1]
+		last.configs = append(last.configs, c)
+	}
+	m.groups = groups
+	return m
+}
+
+// rows returns the rows currently visible in the tree.
+func (m CategoryModel) rows() []categoryRow {
+	var rows []categoryRow
+	for g, group := range m.groups {
+		rows = append(rows, categoryRow{group: g, config: -1})
+		if m.expanded[g] {
+			for c := range group.configs {
+				rows = append(rows, categoryRow{group: g, config: c})
+			}
+		}
+	}
+	return rows
+}
+
+// Update handles updates.
+func (m CategoryModel) Update(msg tea.Msg) (CategoryModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case categoriesMsg:
+		m.all = msg.configs
+		m.err = msg.err
+		m = m.group()
+		m.cursor = 0
+		return m, nil
+
+	case tea.KeyMsg:
+		rows := m.rows()
+		if len(rows) == 0 {
+			return m, nil
+		}
+		row := rows[m.cursor]
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(rows)-1 {
+				m.cursor++
+			}
+		case "enter", " ":
+			// Options are opened by the app, headers toggle
+			if row.config == -1 {
+				m = m.setExpanded(row.group, !m.expanded[row.group])
+			}
+		case "right", "l":
+			if row.config == -1 {
+				m = m.setExpanded(row.group, true)
+			}
+		case "left", "h":
+			m = m.setExpanded(row.group, false)
+		case "p":
+			// Expansion is tracked by group index, which changes on regroup
+			m.allPlatforms = !m.allPlatforms
+			m = m.group()
+			m.expanded = map[int]bool{}
+			m.cursor = 0
+		}
+	}
+	return m, nil
+}
+
+// setExpanded expands or collapses a category, keeping the cursor on it
+// when it collapses under the cursor.
+func (m CategoryModel) setExpanded(group int, expanded bool) CategoryModel {
+	// Copy so earlier model values don't share the map
+	next := make(map[int]bool, len(m.expanded))
+	for g, e := range m.expanded {
+		next[g] = e
+	}
+	next[group] = expanded
+	m.expanded = next
+
+	for i, row := range m.rows() {
+		if row.group == group && row.config == -1 && !expanded {
+			m.cursor = i
+		}
+	}
+	return m
+}
+
+// SelectedConfig returns the option under the cursor, or nil when the
+// cursor is on a category.
+func (m CategoryModel) SelectedConfig() *model.Config {
+	rows := m.rows()
+	if m.cursor >= len(rows) || rows[m.cursor].config == -1 {
+		return nil
+	}
+	row := rows[m.cursor]
+	return &m.groups[row.group].configs[row.config]
+}
+
+// View renders the category tree.
+func (m CategoryModel) View() string {
+	title := categoryTitleStyle.Render("Browse by Category")
+	if m.allPlatforms {
+		title += "  " + categoryCountStyle.Render("all platforms")
+	} else {
+		title += "  " + categoryCountStyle.Render(model.PlatformLabel(currentPlatform)+" options")
+	}
+	header := title + "\n"
+	footer := categoryHelpStyle.Render("↑/↓: navigate • enter: expand/open • ←/→: collapse/expand • p: platforms • esc: back • q: quit")
+
+	listHeight := m.height - lipgloss.Height(header) - lipgloss.Height(footer) - 1
+	if listHeight < 3 {
+		listHeight = 3
+	}
+
+	var content string
+	rows := m.rows()
+	switch {
+	case m.err != nil:
+		content = categoryErrorStyle.Render(fmt.Sprintf("Error loading options: %v", m.err))
+	case len(rows) == 0:
+		content = categoryCountStyle.Render("Loading...")
+	default:
+		start := 0
+		if m.cursor >= listHeight {
+			start = m.cursor - listHeight + 1
+		}
+		end := min(start+listHeight, len(rows))
+
+		var lines []string
+		for i := start; i < end; i++ {
+			lines = append(lines, m.renderRow(i, rows[i]))
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	listSection := lipgloss.NewStyle().
+		Height(listHeight).
+		MaxHeight(listHeight).
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		listSection,
+		footer,
+	)
+}
+
+// renderRow renders a category header or an option row.
+func (m CategoryModel) renderRow(i int, row categoryRow) string {
+	group := m.groups[row.group]
+
+	var text string
+	if row.config == -1 {
+		arrow := "▸"
+		if m.expanded[row.group] {
+			arrow = "▾"
+		}
+		count := categoryCountStyle.Render(fmt.Sprintf("(%d)", len(group.configs)))
+		text = fmt.Sprintf("%s %s %s", arrow, categoryHeaderStyle.Render(group.name), count)
+	} else {
+		c := group.configs[row.config]
+		text = "    " + c.Title
+		if badge := platformBadge(c); badge != "" {
+			text += " " + categoryCountStyle.Render(badge)
+		}
+	}
+
+	if i == m.cursor {
+		return categorySelectedStyle.Render("➤ ") + text
+	}
+	return categoryItemStyle.Render(text)
+}
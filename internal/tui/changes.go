@@ -0,0 +1,267 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/intaek-h/ghofig/internal/db"
+	"github.com/intaek-h/ghofig/internal/diff"
+	"github.com/intaek-h/ghofig/internal/model"
+)
+
+var (
+	changesTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(ThemePrimary)
+
+	changesCountStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	changesItemStyle = lipgloss.NewStyle().
+				PaddingLeft(2)
+
+	changesSelectedStyle = lipgloss.NewStyle().
+				Foreground(ThemePrimary)
+
+	changesAddedStyle = lipgloss.NewStyle().
+				Foreground(ThemeSuccess)
+
+	changesRemovedStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
+
+	changesChangedStyle = lipgloss.NewStyle().
+				Foreground(ThemeWarning)
+
+	changesSetStyle = lipgloss.NewStyle().
+			Foreground(ThemeSecondary)
+
+	changesErrorStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
+
+	changesHelpStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+)
+
+// changesMsg carries the changes between two versions.
+type changesMsg struct {
+	versions []string
+	pair     int
+	changes  []model.Change
+	values   map[string][]string
+	err      error
+}
+
+// ChangesModel lists the options that changed between two Ghostty
+// versions, marking the ones set in the user's config.
+type ChangesModel struct {
+	versions []string
+	pair     int // compares versions[pair] to versions[pair+1]
+	changes  []model.Change
+	values   map[string][]string
+	cursor   int
+	width    int
+	height   int
+	loaded   bool
+	err      error
+}
+
+// NewChangesModel creates a new changes model.
+func NewChangesModel() ChangesModel {
+	return ChangesModel{pair: -1}
+}
+
+// SetSize updates dimensions.
+func (m ChangesModel) SetSize(width, height int) ChangesModel {
+	m.width = width
+	m.height = height
+	return m
+}
+
+// Init loads the changes between the two newest versions.
+func (m ChangesModel) Init() tea.Cmd {
+	return loadChanges(m.pair)
+}
+
+// loadChanges compares versions[pair] to versions[pair+1]. A negative pair
+// selects the two newest versions.
+func loadChanges(pair int) tea.Cmd {
+	return func() tea.Msg {
+		versions, err := db.Versions()
+		if err != nil {
+			return changesMsg{err: err}
+		}
+		if len(versions) < 2 {
+			return changesMsg{versions: versions}
+		}
+		if pair < 0 || pair > len(versions)-2 {
+			pair = len(versions) - 2
+		}
+
+		changes, err := db.Changes(versions[pair], versions[pair+1])
+		return changesMsg{versions: versions, pair: pair, changes: changes, values: loadSetValues(), err: err}
+	}
+}
+
+// Update handles updates.
+func (m ChangesModel) Update(msg tea.Msg) (ChangesModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case changesMsg:
+		m.versions = msg.versions
+		m.pair = msg.pair
+		m.changes = msg.changes
+		m.values = msg.values
+		m.err = msg.err
+		m.loaded = true
+		m.cursor = 0
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.changes)-1 {
+				m.cursor++
+			}
+		case "[":
+			if m.pair > 0 {
+				return m, loadChanges(m.pair - 1)
+			}
+		case "]":
+			if m.pair < len(m.versions)-2 {
+				return m, loadChanges(m.pair + 1)
+			}
+		}
+	}
+	return m, nil
+}
+
+// Selected returns the change under the cursor.
+func (m ChangesModel) Selected() *model.Change {
+	if m.cursor < len(m.changes) {
+		return &m.changes[m.cursor]
+	}
+	return nil
+}
+
+// changeNotes renders how an option's description changed, for the
+// detail view.
+func changeNotes(c model.Change, from, to string) string {
+	switch c.Kind {
+	case model.ChangeAdded:
+		return changesAddedStyle.Render(fmt.Sprintf("Added in %s", to))
+	case model.ChangeRemoved:
+		return changesRemovedStyle.Render(fmt.Sprintf("Removed in %s, the %s docs are shown", to, from))
+	}
+
+	var lines []string
+	lines = append(lines, changesChangedStyle.Render(fmt.Sprintf("Changed between %s and %s:", from, to)))
+	for _, line := range strings.Split(strings.TrimRight(diff.Unified(diff.Lines(c.Old.Description, c.New.Description), 1), "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			line = changesAddedStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			line = changesRemovedStyle.Render(line)
+		default:
+			line = changesCountStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Notes returns the notes shown above the selected option's docs.
+func (m ChangesModel) Notes() string {
+	c := m.Selected()
+	if c == nil {
+		return ""
+	}
+	return changeNotes(*c, m.versions[m.pair], m.versions[m.pair+1])
+}
+
+// View renders the changes view.
+func (m ChangesModel) View() string {
+	titleLine := changesTitleStyle.Render("What's Changed")
+	if len(m.versions) >= 2 && m.err == nil {
+		counts := map[model.ChangeKind]int{}
+		for _, c := range m.changes {
+			counts[c.Kind]++
+		}
+		summary := fmt.Sprintf("%s → %s • %d added, %d removed, %d changed",
+			m.versions[m.pair], m.versions[m.pair+1],
+			counts[model.ChangeAdded], counts[model.ChangeRemoved], counts[model.ChangeDescription])
+		titleLine += "  " + changesCountStyle.Render(summary)
+	}
+	header := titleLine + "\n"
+
+	footer := changesHelpStyle.Render("↑/↓: navigate • enter: view option • [/]: older/newer versions • esc: back • q: quit")
+
+	listHeight := m.height - lipgloss.Height(header) - lipgloss.Height(footer) - 1
+	if listHeight < 3 {
+		listHeight = 3
+	}
+
+	var content string
+	switch {
+	case m.err != nil:
+		content = changesErrorStyle.Render(fmt.Sprintf("Error comparing versions: %v", m.err))
+	case !m.loaded:
+		content = changesCountStyle.Render("Loading...")
+	case len(m.versions) < 2:
+		content = changesCountStyle.Render("Only one Ghostty version is documented")
+	case len(m.changes) == 0:
+		content = changesCountStyle.Render("No options changed")
+	default:
+		start := 0
+		if m.cursor >= listHeight {
+			start = m.cursor - listHeight + 1
+		}
+		end := min(start+listHeight, len(m.changes))
+
+		var lines []string
+		for i := start; i < end; i++ {
+			lines = append(lines, m.renderChange(i))
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	listSection := lipgloss.NewStyle().
+		Height(listHeight).
+		MaxHeight(listHeight).
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		listSection,
+		footer,
+	)
+}
+
+// renderChange renders a single change row.
+func (m ChangesModel) renderChange(i int) string {
+	c := m.changes[i]
+
+	var marker string
+	switch c.Kind {
+	case model.ChangeAdded:
+		marker = changesAddedStyle.Render("+")
+	case model.ChangeRemoved:
+		marker = changesRemovedStyle.Render("-")
+	default:
+		marker = changesChangedStyle.Render("~")
+	}
+
+	row := fmt.Sprintf("%s %-40s %s", marker, c.Title(), changesCountStyle.Render(c.Kind.String()))
+	if _, ok := m.values[c.Title()]; ok {
+		row += "  " + changesSetStyle.Render("● in your config")
+	}
+
+	if i == m.cursor {
+		return changesSelectedStyle.Render("➤ ") + row
+	}
+	return changesItemStyle.Render(row)
+}
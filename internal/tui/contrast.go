@@ -0,0 +1,202 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/theme"
+)
+
+var (
+	contrastTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(ThemePrimary)
+
+	contrastCountStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	contrastItemStyle = lipgloss.NewStyle().
+				PaddingLeft(2)
+
+	contrastSelectedStyle = lipgloss.NewStyle().
+				Foreground(ThemePrimary)
+
+	contrastPassStyle = lipgloss.NewStyle().
+				Foreground(ThemeSuccess)
+
+	contrastFailStyle = lipgloss.NewStyle().
+				Foreground(ThemeWarning)
+
+	contrastErrorStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
+
+	contrastHelpStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+)
+
+// contrastThresholds are the thresholds cycled through with "t".
+var contrastThresholds = []float64{theme.ContrastAA, theme.ContrastAAA, theme.ContrastAALarge}
+
+// contrastThresholdNames labels each threshold.
+var contrastThresholdNames = map[float64]string{
+	theme.ContrastAALarge: "AA large",
+	theme.ContrastAA:      "AA",
+	theme.ContrastAAA:     "AAA",
+}
+
+// contrastReportMsg carries the contrast pairs of the effective colors.
+type contrastReportMsg struct {
+	colors theme.Colors
+	pairs  []theme.Pair
+	err    error
+}
+
+// ContrastModel shows the contrast ratios of the user's color scheme.
+type ContrastModel struct {
+	colors    theme.Colors
+	pairs     []theme.Pair
+	threshold int // index into contrastThresholds
+	cursor    int
+	width     int
+	height    int
+	err       error
+}
+
+// NewContrastModel creates a new contrast model.
+func NewContrastModel() ContrastModel {
+	return ContrastModel{}
+}
+
+// SetSize updates dimensions.
+func (m ContrastModel) SetSize(width, height int) ContrastModel {
+	m.width = width
+	m.height = height
+	return m
+}
+
+// Init resolves the user's colors and computes their contrast.
+func (m ContrastModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		entries, err := config.LoadEntries()
+		if err != nil {
+			return contrastReportMsg{err: err}
+		}
+		colors, err := theme.Resolve(entries, true)
+		if err != nil {
+			return contrastReportMsg{err: err}
+		}
+		return contrastReportMsg{colors: colors, pairs: theme.Contrast(colors)}
+	}
+}
+
+// Update handles updates.
+func (m ContrastModel) Update(msg tea.Msg) (ContrastModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case contrastReportMsg:
+		m.colors = msg.colors
+		m.pairs = msg.pairs
+		m.err = msg.err
+		m.cursor = 0
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.pairs)-1 {
+				m.cursor++
+			}
+		case "t":
+			m.threshold = (m.threshold + 1) % len(contrastThresholds)
+		}
+	}
+	return m, nil
+}
+
+// Threshold returns the contrast ratio pairs are checked against.
+func (m ContrastModel) Threshold() float64 {
+	return contrastThresholds[m.threshold]
+}
+
+// View renders the contrast view.
+func (m ContrastModel) View() string {
+	threshold := m.Threshold()
+
+	titleLine := contrastTitleStyle.Render("Contrast")
+	if len(m.pairs) > 0 {
+		failing := len(theme.Failing(m.pairs, threshold))
+		summary := fmt.Sprintf("%s • %d of %d pairs below %s (%.1f:1)",
+			m.colors.Name, failing, len(m.pairs), contrastThresholdNames[threshold], threshold)
+		titleLine += "  " + contrastCountStyle.Render(summary)
+	}
+	header := titleLine + "\n"
+
+	footer := contrastHelpStyle.Render("↑/↓: navigate • t: threshold • esc: back • q: quit")
+
+	listHeight := m.height - lipgloss.Height(header) - lipgloss.Height(footer) - 1
+	if listHeight < 3 {
+		listHeight = 3
+	}
+
+	var content string
+	switch {
+	case m.err != nil:
+		content = contrastErrorStyle.Render(fmt.Sprintf("Error resolving colors: %v", m.err))
+	case len(m.pairs) == 0:
+		content = contrastCountStyle.Render("Loading...")
+	default:
+		start := 0
+		if m.cursor >= listHeight {
+			start = m.cursor - listHeight + 1
+		}
+		end := min(start+listHeight, len(m.pairs))
+
+		var lines []string
+		for i := start; i < end; i++ {
+			lines = append(lines, m.renderPair(i))
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	listSection := lipgloss.NewStyle().
+		Height(listHeight).
+		MaxHeight(listHeight).
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		listSection,
+		footer,
+	)
+}
+
+// renderPair renders a single pair with a sample of the text it describes.
+func (m ContrastModel) renderPair(i int) string {
+	p := m.pairs[i]
+
+	sample := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(p.Foreground.Hex())).
+		Background(lipgloss.Color(p.Background.Hex())).
+		Render(" Sample Text ")
+
+	status := contrastPassStyle.Render("✓")
+	ratio := fmt.Sprintf("%5.2f:1", p.Ratio)
+	if !p.Passes(m.Threshold()) {
+		status = contrastFailStyle.Render("⚠")
+		ratio = contrastFailStyle.Render(ratio)
+	}
+
+	colors := contrastCountStyle.Render(fmt.Sprintf("%s on %s", p.Foreground.Hex(), p.Background.Hex()))
+	row := fmt.Sprintf("%s %-12s %s  %s  %s", status, p.Name, sample, ratio, colors)
+
+	if i == m.cursor {
+		return contrastSelectedStyle.Render("➤ ") + row
+	}
+	return contrastItemStyle.Render(row)
+}
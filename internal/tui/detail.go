@@ -2,6 +2,8 @@ package tui
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -9,6 +11,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/db"
 	"github.com/intaek-h/ghofig/internal/model"
 )
 
@@ -35,8 +38,42 @@ var (
 
 	detailSuccessStyle = lipgloss.NewStyle().
 				Foreground(ThemeSuccess)
+
+	detailWarningStyle = lipgloss.NewStyle().
+				Foreground(ThemeWarning)
+
+	detailSiblingStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	detailCurrentSiblingStyle = lipgloss.NewStyle().
+					Foreground(ThemeSecondary).
+					Bold(true)
+
+	detailLinkStyle = lipgloss.NewStyle().
+			Foreground(ThemeSecondary)
+
+	detailSelectedLinkStyle = lipgloss.NewStyle().
+				Foreground(ThemePrimary).
+				Bold(true)
+
+	detailCurrentMatchStyle = lipgloss.NewStyle().
+				Foreground(ThemeMatch).
+				Bold(true).
+				Reverse(true)
+
+	detailFindStyle = lipgloss.NewStyle().
+			Foreground(ThemeTextMuted)
 )
 
+// ansiPattern matches the SGR escape sequences lipgloss styles text with.
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// findMatch is an occurrence of the find query in the viewport.
+type findMatch struct {
+	line       int
+	start, end int // byte range in the line without styling
+}
+
 // DetailModel represents the config detail view.
 type DetailModel struct {
 	config           *model.Config
@@ -45,10 +82,24 @@ type DetailModel struct {
 	width            int
 	height           int
 	ready            bool
-	editing          bool   // true when input is active
-	success          bool   // true after successful append
-	message          string // success/error message
-	hasExistingValue bool   // true if editing an existing config value
+	editing          bool           // true when input is active
+	success          bool           // true after successful append
+	message          string         // success/error message
+	hasExistingValue bool           // true if editing an existing config value
+	notes            string         // rendered notes shown above the description
+	action           *model.Action  // set when showing a keybind action instead of an option
+	actions          []model.Action // keybind actions offered as completions
+	siblings         []model.Config // options sharing this option's description
+	related          []model.Config // options the description mentions
+	referencedBy     []model.Config // options whose description mentions this one
+	similar          []model.Config // options with similar docs not linked otherwise
+	link             int            // selected link into related, referencedBy or similar, -1 for none
+	history          []model.Config // options the followed links were opened from
+	finding          bool           // true when the find input is active
+	find             textinput.Model
+	findQuery        string
+	matches          []findMatch
+	match            int // current match
 }
 
 // NewDetailModel creates a new detail model.
@@ -60,8 +111,16 @@ func NewDetailModel() DetailModel {
 	ti.TextStyle = lipgloss.NewStyle().Foreground(ThemeSecondary)
 	ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(ThemeTextMuted)
 
+	fi := textinput.New()
+	fi.CharLimit = 100
+	fi.Width = 30
+	fi.Prompt = "/"
+	fi.TextStyle = lipgloss.NewStyle().Foreground(ThemeTextInput)
+
 	return DetailModel{
 		input: ti,
+		find:  fi,
+		link:  -1,
 	}
 }
 
@@ -72,7 +131,7 @@ func (m DetailModel) SetSize(width, height int) DetailModel {
 
 	// Calculate viewport size (leaving room for title, editor, and help)
 	vpWidth := width - 6
-	vpHeight := height - 12 // More room for editor section
+	vpHeight := m.viewportHeight()
 
 	if !m.ready {
 		m.viewport = viewport.New(vpWidth, vpHeight)
@@ -86,34 +145,321 @@ func (m DetailModel) SetSize(width, height int) DetailModel {
 	// Update input width
 	m.input.Width = min(width-10, 60)
 
-	// Re-set content if we have a config
+	// Re-set content if we have a config, as wrapping moves the matches
 	if m.config != nil {
-		m.viewport.SetContent(m.config.Description)
+		m = m.setFindQuery(m.findQuery)
 	}
 
 	return m
 }
 
+// viewportHeight returns the height left for the description.
+func (m DetailModel) viewportHeight() int {
+	height := m.height - 12 // More room for editor section
+	if len(m.siblings) > 0 {
+		height-- // Variants line
+	}
+	return height
+}
+
 // SetConfig sets the config to display.
 func (m DetailModel) SetConfig(cfg *model.Config) DetailModel {
+	m = m.reset(cfg)
+
+	if cfg != nil {
+		m.siblings, _ = db.Siblings(*cfg)
+		m.related, _ = db.Related(*cfg)
+		m.referencedBy, _ = db.ReferencedBy(*cfg)
+		similar, _ := db.Similar(*cfg)
+		for _, s := range similar {
+			linked := func(c model.Config) bool { return c.ID == s.ID }
+			if !slices.ContainsFunc(m.related, linked) && !slices.ContainsFunc(m.referencedBy, linked) {
+				m.similar = append(m.similar, s)
+			}
+		}
+	}
+
+	if cfg != nil && cfg.Title == "keybind" {
+		m.notes = keybindNotes()
+		m.actions, _ = db.GetActions()
+		m.input.ShowSuggestions = true
+	}
+
+	return m.showTop()
+}
+
+// reset clears everything shown or loaded for the previous config and
+// sets cfg in its place.
+func (m DetailModel) reset(cfg *model.Config) DetailModel {
 	m.config = cfg
 	m.editing = false
 	m.success = false
 	m.message = ""
+	m.notes = ""
+	m.action = nil
+	m.actions = nil
+	m.siblings = nil
+	m.related = nil
+	m.referencedBy = nil
+	m.similar = nil
+	m.link = -1
+	m.history = nil
+	m.finding = false
+	m.findQuery = ""
+	m.matches = nil
+	m.find.Blur()
+	m.input.ShowSuggestions = false
+	m.input.SetSuggestions(nil)
 
 	if cfg != nil {
 		// Set up input with option prefix
 		m.input.SetValue("")
 		m.input.Placeholder = fmt.Sprintf("%s = value", cfg.Title)
 	}
+	return m
+}
 
-	if m.ready && cfg != nil {
-		m.viewport.SetContent(cfg.Description)
+// showTop renders the config into the viewport, scrolled to the top.
+func (m DetailModel) showTop() DetailModel {
+	if m.ready && m.config != nil {
+		m.viewport.Height = m.viewportHeight()
+		m.viewport.SetContent(m.content())
 		m.viewport.GotoTop()
 	}
 	return m
 }
 
+// siblingIndex returns the position of the shown option among its
+// siblings.
+func (m DetailModel) siblingIndex() int {
+	for i, s := range m.siblings {
+		if s.ID == m.config.ID {
+			return i
+		}
+	}
+	return 0
+}
+
+// showSibling switches to the sibling delta places away, wrapping around.
+func (m DetailModel) showSibling(delta int) DetailModel {
+	if len(m.siblings) < 2 {
+		return m
+	}
+	i := (m.siblingIndex() + delta + len(m.siblings)) % len(m.siblings)
+	sibling := m.siblings[i]
+	history := m.history
+	m = m.SetConfig(&sibling)
+	m.history = history
+	return m
+}
+
+// links returns the options linked from the view: the related options,
+// the ones referencing this option, then the similar ones.
+func (m DetailModel) links() []model.Config {
+	return slices.Concat(m.related, m.referencedBy, m.similar)
+}
+
+// selectLink moves the link selection delta places, wrapping around, and
+// scrolls the selected link into view.
+func (m DetailModel) selectLink(delta int) DetailModel {
+	links := m.links()
+	if len(links) == 0 {
+		return m
+	}
+	if m.link == -1 && delta < 0 {
+		m.link = len(links) - 1
+	} else {
+		m.link = (m.link + delta + len(links)) % len(links)
+	}
+
+	_, linkLines := m.renderLines()
+	m.viewport.SetContent(m.content())
+	m.scrollTo(linkLines[m.link])
+	return m
+}
+
+// scrollTo scrolls the viewport to line unless it's already visible.
+func (m *DetailModel) scrollTo(line int) {
+	if line < m.viewport.YOffset || line >= m.viewport.YOffset+m.viewport.Height {
+		m.viewport.SetYOffset(line - m.viewport.Height/2)
+	}
+}
+
+// followLink opens the selected link, remembering the current option so
+// GoBack can return to it.
+func (m DetailModel) followLink() DetailModel {
+	target := m.links()[m.link]
+	history := append(slices.Clip(m.history), *m.config)
+	m = m.SetConfig(&target)
+	m.history = history
+	return m
+}
+
+// CanGoBack returns whether the option was opened from a link.
+func (m DetailModel) CanGoBack() bool {
+	return len(m.history) > 0
+}
+
+// GoBack returns to the option the last followed link was opened from.
+func (m DetailModel) GoBack() DetailModel {
+	prev := m.history[len(m.history)-1]
+	history := m.history[:len(m.history)-1]
+	m = m.SetConfig(&prev)
+	m.history = history
+	return m
+}
+
+// SetNotes replaces the notes shown above the description.
+func (m DetailModel) SetNotes(notes string) DetailModel {
+	m.notes = notes
+	if m.ready && m.config != nil {
+		m.viewport.SetContent(m.content())
+	}
+	return m
+}
+
+// SetAction shows the documentation of a keybind action. Actions are
+// not config options, so the view is read-only.
+func (m DetailModel) SetAction(action *model.Action) DetailModel {
+	description := action.Description
+	if action.Parameters != "" {
+		description = fmt.Sprintf("Parameters: %s\n\n%s", action.Parameters, description)
+	}
+
+	// Actions aren't options, so there are no siblings or links to look up
+	m = m.reset(&model.Config{ID: action.ID, Title: action.Name, Description: description})
+	m.action = action
+	return m.showTop()
+}
+
+// content returns the text shown in the viewport.
+func (m DetailModel) content() string {
+	lines, _ := m.renderLines()
+	return strings.Join(m.highlightMatches(lines), "\n")
+}
+
+// renderLines returns the lines shown in the viewport, before matches are
+// highlighted, and the line each link is on.
+func (m DetailModel) renderLines() ([]string, []int) {
+	width := 80
+	if m.ready {
+		width = m.viewport.Width - 1
+	}
+	content := renderMarkdown(m.config.Description, width)
+	if m.notes != "" {
+		content = m.notes + "\n\n" + content
+	}
+
+	out := strings.Split(content, "\n")
+	var linkLines []int
+	section := func(title string, links []model.Config, offset int) {
+		if len(links) == 0 {
+			return
+		}
+		out = append(out, "", detailTitleStyle.Render(title))
+		for i, c := range links {
+			linkLines = append(linkLines, len(out))
+			if offset+i == m.link {
+				out = append(out, detailSelectedLinkStyle.Render("➤ "+c.Title))
+			} else {
+				out = append(out, "  "+detailLinkStyle.Render(c.Title))
+			}
+		}
+	}
+	section("Related options", m.related, 0)
+	section("Referenced by", m.referencedBy, len(m.related))
+	section("You might also want", m.similar, len(m.related)+len(m.referencedBy))
+
+	return out, linkLines
+}
+
+// setFindQuery finds query in the viewport and moves to the first match
+// at or below the top of the view.
+func (m DetailModel) setFindQuery(query string) DetailModel {
+	m.findQuery = query
+	m.matches = nil
+	m.match = 0
+
+	if query != "" {
+		lines, _ := m.renderLines()
+		for i, line := range lines {
+			plain := ansiPattern.ReplaceAllString(line, "")
+			for offset := 0; ; {
+				start, end := indexFold(plain[offset:], query)
+				if start == -1 {
+					break
+				}
+				m.matches = append(m.matches, findMatch{line: i, start: offset + start, end: offset + end})
+				offset += end
+			}
+		}
+		for i, match := range m.matches {
+			if match.line >= m.viewport.YOffset {
+				m.match = i
+				break
+			}
+		}
+	}
+
+	if m.ready {
+		m.viewport.SetContent(m.content())
+		if len(m.matches) > 0 {
+			m.scrollTo(m.matches[m.match].line)
+		}
+	}
+	return m
+}
+
+// nextMatch moves delta matches on, wrapping around.
+func (m DetailModel) nextMatch(delta int) DetailModel {
+	if len(m.matches) == 0 {
+		return m
+	}
+	m.match = (m.match + delta + len(m.matches)) % len(m.matches)
+	m.viewport.SetContent(m.content())
+	m.scrollTo(m.matches[m.match].line)
+	return m
+}
+
+// highlightMatches highlights the find query in the lines that contain
+// it. Those lines lose their other styling so the matches stand out.
+func (m DetailModel) highlightMatches(lines []string) []string {
+	if len(m.matches) == 0 {
+		return lines
+	}
+
+	lines = slices.Clone(lines)
+	current := m.matches[m.match]
+	seen := map[int]bool{}
+	for _, match := range m.matches {
+		if seen[match.line] {
+			continue
+		}
+		seen[match.line] = true
+
+		plain := ansiPattern.ReplaceAllString(lines[match.line], "")
+		if match.line != current.line {
+			lines[match.line] = highlightWithStyle(plain, m.findQuery, lipgloss.NewStyle(), searchMatchStyle)
+			continue
+		}
+		lines[match.line] = highlightWithStyle(plain[:current.start], m.findQuery, lipgloss.NewStyle(), searchMatchStyle) +
+			detailCurrentMatchStyle.Render(plain[current.start:current.end]) +
+			highlightWithStyle(plain[current.end:], m.findQuery, lipgloss.NewStyle(), searchMatchStyle)
+	}
+	return lines
+}
+
+// keybindNotes summarizes conflicts between the user's keybinds.
+func keybindNotes() string {
+	report, err := loadKeybindReport()
+	if err != nil || len(report.Conflicts) == 0 {
+		return ""
+	}
+
+	header := detailTitleStyle.Render(fmt.Sprintf("Your keybinds have %d conflicts", len(report.Conflicts)))
+	return header + "\n" + strings.Join(keybindReportLines(report), "\n")
+}
+
 // configAppendedMsg is sent when config is successfully appended
 type configAppendedMsg struct {
 	success bool
@@ -136,6 +482,10 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
 			m.success = true
 			m.message = "✓ Added to config file"
 			m.editing = false
+			if m.config.Title == "keybind" {
+				m.notes = keybindNotes()
+				m.viewport.SetContent(m.content())
+			}
 		} else {
 			m.message = fmt.Sprintf("Error: %v", msg.err)
 		}
@@ -155,6 +505,26 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.finding {
+			switch msg.String() {
+			case "enter":
+				m.finding = false
+				m.find.Blur()
+				return m, nil
+			case "esc":
+				// Cancel the find and clear its highlights
+				m.finding = false
+				m.find.Blur()
+				m.find.SetValue("")
+				return m.setFindQuery(""), nil
+			}
+			m.find, cmd = m.find.Update(msg)
+			if m.find.Value() != m.findQuery {
+				m = m.setFindQuery(m.find.Value())
+			}
+			return m, cmd
+		}
+
 		if m.editing {
 			// In editing mode
 			switch msg.String() {
@@ -190,12 +560,36 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
 			}
 			// Forward to text input
 			m.input, cmd = m.input.Update(msg)
+			if len(m.actions) > 0 {
+				m.input.SetSuggestions(actionSuggestions(m.input.Value(), m.actions))
+			}
 			return m, cmd
 		}
 
 		// Not editing - normal navigation
 		switch msg.String() {
+		case "/":
+			m.finding = true
+			m.find.SetValue(m.findQuery)
+			m.find.CursorEnd()
+			m.find.Focus()
+			return m, textinput.Blink
+		case "n":
+			return m.nextMatch(1), nil
+		case "N":
+			return m.nextMatch(-1), nil
+		case "tab":
+			return m.selectLink(1), nil
+		case "shift+tab":
+			return m.selectLink(-1), nil
 		case "enter":
+			if m.link >= 0 {
+				return m.followLink(), nil
+			}
+			// Actions are documentation only
+			if m.action != nil {
+				return m, nil
+			}
 			// Start editing
 			m.editing = true
 			m.success = false
@@ -210,6 +604,9 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
 			}
 			m.input.CursorEnd()
 			m.input.Focus()
+			if len(m.actions) > 0 {
+				m.input.SetSuggestions(actionSuggestions(m.input.Value(), m.actions))
+			}
 			return m, textinput.Blink
 		case "up", "k":
 			m.viewport.LineUp(1)
@@ -223,6 +620,10 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
 			m.viewport.GotoTop()
 		case "end", "G":
 			m.viewport.GotoBottom()
+		case "[":
+			return m.showSibling(-1), nil
+		case "]":
+			return m.showSibling(1), nil
 		}
 	}
 
@@ -240,6 +641,33 @@ func (m DetailModel) View() string {
 
 	// Title
 	b.WriteString(detailTitleStyle.Render(m.config.Title))
+	if badge := platformBadge(*m.config); badge != "" {
+		style := detailEditorHintStyle
+		if !m.config.AppliesTo(currentPlatform) {
+			style = detailWarningStyle
+			badge += fmt.Sprintf(" no effect on %s", model.PlatformLabel(currentPlatform))
+		}
+		b.WriteString("  " + style.Render(badge))
+	}
+	if since := m.config.Since; since != "" {
+		if ghosttyVersion.Supports(since) {
+			b.WriteString("  " + detailEditorHintStyle.Render("since "+since))
+		} else {
+			b.WriteString("  " + detailWarningStyle.Render(fmt.Sprintf("requires Ghostty %s, you have %s", since, ghosttyVersion)))
+		}
+	}
+	if len(m.siblings) > 0 {
+		current := m.siblingIndex()
+		names := make([]string, len(m.siblings))
+		for i, s := range m.siblings {
+			if i == current {
+				names[i] = detailCurrentSiblingStyle.Render(s.Title)
+			} else {
+				names[i] = detailSiblingStyle.Render(s.Title)
+			}
+		}
+		b.WriteString("\n" + detailSiblingStyle.Render("Variants: ") + strings.Join(names, detailSiblingStyle.Render(" · ")))
+	}
 	b.WriteString("\n\n")
 
 	// Editor section
@@ -252,12 +680,27 @@ func (m DetailModel) View() string {
 		if m.hasExistingValue {
 			hint += " | Clear value to comment out"
 		}
+		if action := m.completedAction(); action != nil {
+			hint = fmt.Sprintf("  %s", action.Name)
+			if action.Parameters != "" {
+				hint += fmt.Sprintf(":%s", action.Parameters)
+			}
+			hint += " | Tab: complete, ↑/↓: cycle"
+		}
 		b.WriteString(detailEditorHintStyle.Render(hint))
 		b.WriteString("\n\n")
 	} else if m.success {
 		// Show success message
 		b.WriteString(detailSuccessStyle.Render("  " + m.message))
 		b.WriteString("\n\n")
+	} else if m.action != nil {
+		// Actions can't be set directly, show how to bind one
+		b.WriteString(detailEditorHintStyle.Render(fmt.Sprintf("  Use in a keybind: keybind = <trigger>=%s", m.action.Name)))
+		b.WriteString("\n\n")
+	} else if m.link >= 0 {
+		// Enter opens the selected link instead
+		b.WriteString(detailEditorHintStyle.Render(fmt.Sprintf("    ○ Open Editor For `%s`", m.config.Title)))
+		b.WriteString("\n\n")
 	} else {
 		// Show editor item
 		b.WriteString(detailEditorItemStyle.Render(fmt.Sprintf("  ➤ ○ Open Editor For `%s`", m.config.Title)))
@@ -269,14 +712,23 @@ func (m DetailModel) View() string {
 		content := detailViewportStyle.Render(m.viewport.View())
 		b.WriteString(content)
 	} else {
-		b.WriteString(detailContentStyle.Render(m.config.Description))
+		b.WriteString(detailContentStyle.Render(m.content()))
 	}
 
-	// Scroll indicator
-	scrollInfo := ""
-	if m.ready {
+	// Find bar, or scroll indicator
+	if m.finding || m.findQuery != "" {
+		counter := "no matches"
+		if len(m.matches) > 0 {
+			counter = fmt.Sprintf("%d/%d", m.match+1, len(m.matches))
+		}
+		bar := detailFindStyle.Render("/" + m.findQuery)
+		if m.finding {
+			bar = m.find.View()
+		}
+		b.WriteString("\n  " + bar + "  " + detailFindStyle.Render(counter))
+	} else if m.ready {
 		scrollPercent := m.viewport.ScrollPercent() * 100
-		scrollInfo = lipgloss.NewStyle().
+		scrollInfo := lipgloss.NewStyle().
 			Foreground(ThemeTextMuted).
 			Render(strings.Repeat(" ", m.width-20) +
 				lipgloss.NewStyle().Render(
@@ -287,16 +739,35 @@ func (m DetailModel) View() string {
 						return ""
 					}(),
 				))
+		b.WriteString(scrollInfo)
 	}
-	b.WriteString(scrollInfo)
 	b.WriteString("\n")
 
 	// Help
 	var help string
 	if m.editing {
 		help = "enter: save • esc: cancel"
+	} else if m.finding {
+		help = "enter: done • esc: cancel"
 	} else {
-		help = "enter: edit • ↑/↓: scroll • pgup/pgdn: page • esc: back • q: quit"
+		var parts []string
+		if m.link >= 0 {
+			parts = append(parts, "enter: open link")
+		} else if m.action == nil {
+			parts = append(parts, "enter: edit")
+		}
+		parts = append(parts, "↑/↓: scroll", "pgup/pgdn: page", "/: find")
+		if len(m.matches) > 0 {
+			parts = append(parts, "n/N: next/prev")
+		}
+		if len(m.links()) > 0 {
+			parts = append(parts, "tab: links")
+		}
+		if len(m.siblings) > 0 {
+			parts = append(parts, "[/]: variants")
+		}
+		parts = append(parts, "esc: back", "q: quit")
+		help = strings.Join(parts, " • ")
 	}
 	b.WriteString(detailHelpStyle.Render(help))
 
@@ -307,3 +778,76 @@ func (m DetailModel) View() string {
 func (m DetailModel) IsEditing() bool {
 	return m.editing
 }
+
+// IsInputFocused returns whether the editor or the find input takes keys.
+func (m DetailModel) IsInputFocused() bool {
+	return m.editing || m.finding
+}
+
+// completedAction returns the keybind action currently being typed.
+func (m DetailModel) completedAction() *model.Action {
+	if len(m.actions) == 0 || !m.editing {
+		return nil
+	}
+
+	suggestions := m.input.MatchedSuggestions()
+	if len(suggestions) == 0 {
+		return nil
+	}
+
+	_, typed, ok := splitKeybindAction(suggestions[m.input.CurrentSuggestionIndex()])
+	if !ok {
+		return nil
+	}
+	name, _, _ := strings.Cut(typed, ":")
+	for i := range m.actions {
+		if m.actions[i].Name == name {
+			return &m.actions[i]
+		}
+	}
+	return nil
+}
+
+// actionSuggestions returns completions for the action part of a
+// `keybind = trigger=action` input. Every action name is offered, plus
+// name:param pairs for actions with a fixed set of parameters. The input
+// filters them down by prefix as the user types.
+func actionSuggestions(value string, actions []model.Action) []string {
+	prefix, _, ok := splitKeybindAction(value)
+	if !ok {
+		return nil
+	}
+
+	var suggestions []string
+	for _, a := range actions {
+		if a.Parameters == "" {
+			suggestions = append(suggestions, prefix+a.Name)
+			continue
+		}
+		suggestions = append(suggestions, prefix+a.Name+":")
+		if strings.HasPrefix(a.Parameters, "<") {
+			continue // Free-form parameter
+		}
+		for _, param := range strings.Split(a.Parameters, ", ") {
+			suggestions = append(suggestions, prefix+a.Name+":"+param)
+		}
+	}
+	return suggestions
+}
+
+// splitKeybindAction splits "keybind = ctrl+a=new_tab" into the part up
+// to and including the "=" after the trigger, and the action typed so far.
+func splitKeybindAction(value string) (prefix, action string, ok bool) {
+	eq := strings.Index(value, "=")
+	if eq == -1 {
+		return "", "", false
+	}
+
+	// A "=" directly after "+" is the key itself, as in ctrl+=
+	for i := eq + 1; i < len(value); i++ {
+		if value[i] == '=' && value[i-1] != '+' {
+			return value[:i+1], value[i+1:], true
+		}
+	}
+	return "", "", false
+}
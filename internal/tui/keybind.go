@@ -0,0 +1,236 @@
+package tui
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/intaek-h/ghofig/internal/config"
+	"github.com/intaek-h/ghofig/internal/keybind"
+)
+
+var (
+	keybindTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(ThemePrimary)
+
+	keybindCountStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	keybindItemStyle = lipgloss.NewStyle().
+				PaddingLeft(2)
+
+	keybindSelectedStyle = lipgloss.NewStyle().
+				Foreground(ThemePrimary)
+
+	keybindActionStyle = lipgloss.NewStyle().
+				Foreground(ThemeSecondary)
+
+	keybindLocationStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	keybindWarningStyle = lipgloss.NewStyle().
+				Foreground(ThemeWarning)
+
+	keybindInfoStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	keybindErrorStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
+
+	keybindHelpStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+)
+
+// loadKeybindReport analyzes the keybinds in the user's config against
+// the bundled defaults for the current platform.
+func loadKeybindReport() (keybind.Report, error) {
+	entries, err := config.LoadEntries()
+	if err != nil {
+		return keybind.Report{}, err
+	}
+	return keybind.Check(entries, keybind.Defaults(runtime.GOOS)), nil
+}
+
+// keybindReportLines renders the conflicts of a report, one per line.
+func keybindReportLines(report keybind.Report) []string {
+	var lines []string
+	for _, c := range report.Conflicts {
+		style := keybindInfoStyle
+		if c.Kind.IsWarning() {
+			style = keybindWarningStyle
+		}
+		lines = append(lines, style.Render(fmt.Sprintf("%s %s: %s", conflictMarker(c.Kind), c.Binding.Location(), c.Message)))
+	}
+	return lines
+}
+
+// conflictMarker returns the symbol shown next to a conflicting binding.
+func conflictMarker(kind keybind.Kind) string {
+	if kind.IsWarning() {
+		return "⚠"
+	}
+	return "ℹ"
+}
+
+// keybindReportMsg carries the result of loading the keybind report.
+type keybindReportMsg struct {
+	report keybind.Report
+	err    error
+}
+
+// KeybindModel lists the user's keybinds and their conflicts.
+type KeybindModel struct {
+	report keybind.Report
+	cursor int
+	width  int
+	height int
+	err    error
+}
+
+// NewKeybindModel creates a new keybind model.
+func NewKeybindModel() KeybindModel {
+	return KeybindModel{}
+}
+
+// SetSize updates dimensions.
+func (m KeybindModel) SetSize(width, height int) KeybindModel {
+	m.width = width
+	m.height = height
+	return m
+}
+
+// Init loads and analyzes the user's keybinds.
+func (m KeybindModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		report, err := loadKeybindReport()
+		return keybindReportMsg{report: report, err: err}
+	}
+}
+
+// Update handles updates.
+func (m KeybindModel) Update(msg tea.Msg) (KeybindModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case keybindReportMsg:
+		m.report = msg.report
+		m.err = msg.err
+		m.cursor = 0
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.report.Bindings)-1 {
+				m.cursor++
+			}
+		}
+	}
+	return m, nil
+}
+
+// View renders the keybind view.
+func (m KeybindModel) View() string {
+	bindings := m.report.Bindings
+
+	titleLine := keybindTitleStyle.Render("Keybinds")
+	if len(bindings) > 0 {
+		summary := fmt.Sprintf("%d bindings, %d conflicts", len(bindings), len(m.report.Conflicts))
+		titleLine += "  " + keybindCountStyle.Render(summary)
+	}
+	header := titleLine + "\n"
+
+	footer := keybindHelpStyle.Render("↑/↓: navigate • esc: back • q: quit")
+
+	// Conflicts of the selected binding are shown below the list
+	var details []string
+	if m.cursor < len(bindings) {
+		for _, c := range m.report.ConflictsFor(bindings[m.cursor]) {
+			style := keybindInfoStyle
+			if c.Kind.IsWarning() {
+				style = keybindWarningStyle
+			}
+			details = append(details, style.Render(fmt.Sprintf("  %s %s: %s", conflictMarker(c.Kind), c.Kind, c.Message)))
+		}
+	}
+	detailSection := strings.Join(details, "\n")
+
+	listHeight := m.height - lipgloss.Height(header) - lipgloss.Height(footer) - len(details) - 2
+	if listHeight < 3 {
+		listHeight = 3
+	}
+
+	var content string
+	switch {
+	case m.err != nil:
+		content = keybindErrorStyle.Render(fmt.Sprintf("Error reading config: %v", m.err))
+	case len(bindings) == 0:
+		content = keybindCountStyle.Render("No keybinds in your config")
+	default:
+		start := 0
+		if m.cursor >= listHeight {
+			start = m.cursor - listHeight + 1
+		}
+		end := min(start+listHeight, len(bindings))
+
+		var lines []string
+		for i := start; i < end; i++ {
+			lines = append(lines, m.renderBinding(i))
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	listSection := lipgloss.NewStyle().
+		Height(listHeight).
+		MaxHeight(listHeight).
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		listSection,
+		detailSection,
+		footer,
+	)
+}
+
+// renderBinding renders a single binding row.
+func (m KeybindModel) renderBinding(i int) string {
+	b := m.report.Bindings[i]
+
+	marker := " "
+	conflicts := m.report.ConflictsFor(b)
+	for _, c := range conflicts {
+		marker = keybindInfoStyle.Render(conflictMarker(c.Kind))
+		if c.Kind.IsWarning() {
+			marker = keybindWarningStyle.Render(conflictMarker(c.Kind))
+			break
+		}
+	}
+
+	var trigger, action string
+	if b.Clear {
+		trigger = "clear"
+	} else if len(b.Sequence) == 0 {
+		// Bindings that failed to parse only have their raw value
+		trigger = b.Raw
+	} else {
+		trigger = b.Trigger()
+		if len(b.Prefixes) > 0 {
+			trigger = strings.Join(b.Prefixes, ":") + ":" + trigger
+		}
+		action = keybindActionStyle.Render(b.Action)
+	}
+
+	location := keybindLocationStyle.Render(b.Location())
+	row := fmt.Sprintf("%s %-24s %s  %s", marker, trigger, action, location)
+
+	if i == m.cursor {
+		return keybindSelectedStyle.Render("➤ ") + row
+	}
+	return keybindItemStyle.Render(row)
+}
@@ -0,0 +1,279 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	markdownCodeStyle = lipgloss.NewStyle().
+				Foreground(ThemeSecondary)
+
+	markdownTermStyle = lipgloss.NewStyle().
+				Foreground(ThemeSecondary).
+				Bold(true)
+
+	markdownLabelStyle = lipgloss.NewStyle().
+				Bold(true)
+
+	markdownBulletStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	markdownFenceStyle = lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(ThemeTextMuted).
+				Foreground(ThemeSecondary).
+				Padding(0, 1)
+)
+
+// listItemPattern matches "* item", "- item" and "1. item" lines.
+var listItemPattern = regexp.MustCompile(`^(\s*)([*-]|\d+\.)\s+(.*)$`)
+
+// termPattern matches list items defining a value, like "`block` - A block".
+var termPattern = regexp.MustCompile("^`([^`]+)`( - |: )(.*)$")
+
+// linkPattern matches Markdown links like "[text](url)".
+var linkPattern = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+
+// mdxTagPattern matches MDX components like "<Note>", which are shown as
+// plain text.
+var mdxTagPattern = regexp.MustCompile(`</?[A-Z][A-Za-z]*>`)
+
+// maxLabelWidth is how long a line ending in ":" can be to be shown as a
+// label, like "Valid values:".
+const maxLabelWidth = 40
+
+// markdownBlock is a paragraph, list item or code fence.
+type markdownBlock struct {
+	kind   blockKind
+	text   string // paragraph or item text, joined into one line
+	lines  []string
+	marker string // list item marker, "*" or "1."
+	nested bool   // list item inside another item
+}
+
+type blockKind int
+
+const (
+	blockParagraph blockKind = iota
+	blockItem
+	blockFence
+)
+
+// renderMarkdown renders the Markdown of Ghostty's docs for the terminal:
+// paragraphs are reflowed to width, inline code is styled, lists get
+// hanging indents, and code fences are drawn in a box, their long lines cut.
+func renderMarkdown(text string, width int) string {
+	if width < 20 {
+		width = 20
+	}
+
+	blocks := parseMarkdown(text)
+	var out strings.Builder
+	for i, block := range blocks {
+		if i > 0 {
+			// List items sit on consecutive lines
+			if block.kind == blockItem && blocks[i-1].kind == blockItem {
+				out.WriteString("\n")
+			} else {
+				out.WriteString("\n\n")
+			}
+		}
+		switch block.kind {
+		case blockFence:
+			// Code isn't reflowed, so cut long lines and mark where
+			inner := width - markdownFenceStyle.GetHorizontalFrameSize()
+			lines := make([]string, len(block.lines))
+			for i, line := range block.lines {
+				lines[i] = truncateLine(line, inner)
+			}
+			out.WriteString(markdownFenceStyle.Render(strings.Join(lines, "\n")))
+		case blockItem:
+			out.WriteString(renderItem(block, width))
+		default:
+			if isLabel(block.text) {
+				out.WriteString(markdownLabelStyle.Render(block.text))
+				continue
+			}
+			out.WriteString(strings.Join(styleInline(wrapWords(block.text, width)), "\n"))
+		}
+	}
+	return out.String()
+}
+
+// parseMarkdown splits text into blocks. Lines of a paragraph or list item
+// are joined so they can be reflowed.
+func parseMarkdown(text string) []markdownBlock {
+	var blocks []markdownBlock
+	var current *markdownBlock
+	itemIndent := 0
+
+	flush := func() {
+		if current != nil {
+			blocks = append(blocks, *current)
+			current = nil
+		}
+	}
+
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if !strings.HasPrefix(trimmed, "```") {
+			line = linkPattern.ReplaceAllString(mdxTagPattern.ReplaceAllString(line, ""), "$1 ($2)")
+			trimmed = strings.TrimSpace(line)
+		}
+
+		if strings.HasPrefix(trimmed, "```") {
+			flush()
+			indent := len(line) - len(strings.TrimLeft(line, " "))
+			fence := markdownBlock{kind: blockFence}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				fence.lines = append(fence.lines, strings.TrimPrefix(lines[i], strings.Repeat(" ", indent)))
+			}
+			blocks = append(blocks, fence)
+			continue
+		}
+
+		if trimmed == "" {
+			flush()
+			continue
+		}
+
+		if match := listItemPattern.FindStringSubmatch(line); match != nil {
+			flush()
+			itemIndent = len(match[1])
+			current = &markdownBlock{
+				kind:   blockItem,
+				text:   match[3],
+				marker: match[2],
+				nested: itemIndent >= 4,
+			}
+			continue
+		}
+
+		if current != nil {
+			// Continuation lines of an item are indented past its marker
+			if current.kind == blockItem && len(line)-len(strings.TrimLeft(line, " ")) <= itemIndent {
+				flush()
+			} else {
+				current.text += " " + trimmed
+				continue
+			}
+		}
+		current = &markdownBlock{kind: blockParagraph, text: trimmed}
+	}
+	flush()
+
+	return blocks
+}
+
+// renderItem renders a list item with its marker and a hanging indent.
+// Items defining a value start with the value in bold.
+func renderItem(block markdownBlock, width int) string {
+	marker := "•"
+	if block.marker != "*" && block.marker != "-" {
+		marker = block.marker
+	}
+	indent := "  "
+	if block.nested {
+		indent = "    "
+	}
+	hang := strings.Repeat(" ", len(indent)+lipgloss.Width(marker)+1)
+
+	text := block.text
+	term := ""
+	if match := termPattern.FindStringSubmatch(text); match != nil {
+		term = match[1]
+		text = strings.TrimSpace(match[2] + match[3])
+		if match[3] == "" {
+			text = ""
+		}
+	}
+
+	var lines []string
+	if term != "" {
+		// Wrap with a placeholder as wide as the term, then style it
+		placeholder := strings.Repeat("x", lipgloss.Width(term))
+		lines = styleInline(wrapWords(strings.TrimSpace(placeholder+" "+text), width-len(hang)))
+		lines[0] = markdownTermStyle.Render(term) + strings.TrimPrefix(lines[0], placeholder)
+	} else {
+		lines = styleInline(wrapWords(text, width-len(hang)))
+	}
+
+	for i := range lines {
+		if i == 0 {
+			lines[i] = indent + markdownBulletStyle.Render(marker) + " " + lines[i]
+		} else {
+			lines[i] = hang + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isLabel reports whether a paragraph is a short label introducing what
+// follows, like "Valid values:".
+func isLabel(text string) bool {
+	return strings.HasSuffix(text, ":") && len(text) <= maxLabelWidth && !strings.Contains(text, "`")
+}
+
+// wrapWords wraps text at spaces to lines of at most width cells. Words
+// longer than width get a line of their own.
+func wrapWords(text string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	for _, word := range strings.Fields(text) {
+		if line.Len() > 0 && lipgloss.Width(line.String())+1+lipgloss.Width(word) > width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteString(" ")
+		}
+		line.WriteString(word)
+	}
+	if line.Len() > 0 || len(lines) == 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// truncateLine cuts line to width cells, ending it with "…" when cut.
+func truncateLine(line string, width int) string {
+	if lipgloss.Width(line) <= width {
+		return line
+	}
+	runes := []rune(line)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
+// styleInline styles `code` spans in wrapped lines, dropping the
+// backticks. Spans broken across lines are styled on both.
+func styleInline(lines []string) []string {
+	inCode := false
+	styled := make([]string, len(lines))
+	for i, line := range lines {
+		var b strings.Builder
+		for j, part := range strings.Split(line, "`") {
+			if j > 0 {
+				inCode = !inCode
+			}
+			if part == "" {
+				continue
+			}
+			if inCode {
+				b.WriteString(markdownCodeStyle.Render(part))
+			} else {
+				b.WriteString(part)
+			}
+		}
+		styled[i] = b.String()
+	}
+	return styled
+}
@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []markdownBlock
+	}{
+		{
+			name: "paragraphs are joined",
+			text: "The style of\nthe cursor.\n\nSecond paragraph.",
+			want: []markdownBlock{
+				{kind: blockParagraph, text: "The style of the cursor."},
+				{kind: blockParagraph, text: "Second paragraph."},
+			},
+		},
+		{
+			name: "fences keep their lines",
+			text: "Example:\n\n  ```ini\n  keybind = ctrl+a=select_all\n    indented\n  ```\n\nAfter.",
+			want: []markdownBlock{
+				{kind: blockParagraph, text: "Example:"},
+				{kind: blockFence, lines: []string{"keybind = ctrl+a=select_all", "  indented"}},
+				{kind: blockParagraph, text: "After."},
+			},
+		},
+		{
+			name: "valid values with nested items",
+			text: "Valid values:\n\n  * `block` - A block\n    that wraps\n      * nested item\n  * `bar`\n\n1. First",
+			want: []markdownBlock{
+				{kind: blockParagraph, text: "Valid values:"},
+				{kind: blockItem, text: "`block` - A block that wraps", marker: "*"},
+				{kind: blockItem, text: "nested item", marker: "*", nested: true},
+				{kind: blockItem, text: "`bar`", marker: "*"},
+				{kind: blockItem, text: "First", marker: "1."},
+			},
+		},
+		{
+			name: "links and MDX tags",
+			text: "<Note>\nSee [the docs](https://ghostty.org).\n</Note>",
+			want: []markdownBlock{
+				{kind: blockParagraph, text: "See the docs (https://ghostty.org)."},
+			},
+		},
+	}
+	for _, tt := range tests {
+		if got := parseMarkdown(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: parseMarkdown =\n%#v\nwant\n%#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWrapWords(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"", 10, []string{""}},
+		{"short", 10, []string{"short"}},
+		{"one two three four", 9, []string{"one two", "three", "four"}},
+		{"exactly ten", 11, []string{"exactly ten"}},
+		{"a background-opacity-cells b", 10, []string{"a", "background-opacity-cells", "b"}},
+		{"spaces   are\n collapsed", 40, []string{"spaces are collapsed"}},
+	}
+	for _, tt := range tests {
+		if got := wrapWords(tt.text, tt.width); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("wrapWords(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestStyleInline(t *testing.T) {
+	code := markdownCodeStyle.Render
+	tests := []struct {
+		lines []string
+		want  []string
+	}{
+		{[]string{"plain"}, []string{"plain"}},
+		{[]string{"set `font-size` here"}, []string{"set " + code("font-size") + " here"}},
+		// A span broken across lines is styled on both
+		{[]string{"see `two", "words` after"}, []string{"see " + code("two"), code("words") + " after"}},
+	}
+	for _, tt := range tests {
+		if got := styleInline(tt.lines); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("styleInline(%q) = %q, want %q", tt.lines, got, tt.want)
+		}
+	}
+}
+
+func TestRenderMarkdownFences(t *testing.T) {
+	long := strings.Repeat("x", 60)
+	out := renderMarkdown("```\n"+long+"\nshort\n```", 30)
+	for _, line := range strings.Split(out, "\n") {
+		if w := lipgloss.Width(line); w > 30 {
+			t.Errorf("line %q is %d wide, want at most 30", line, w)
+		}
+	}
+	if !strings.Contains(out, "…") {
+		t.Errorf("cut fence line should be marked:\n%s", out)
+	}
+	if !strings.Contains(out, "short") {
+		t.Errorf("short fence line should be kept:\n%s", out)
+	}
+}
@@ -71,14 +71,24 @@ type MenuModel struct {
 // Menu item indices for selection handling
 const (
 	MenuItemConfigOptions = iota
+	MenuItemCategories
+	MenuItemMyConfig
 	MenuItemConfigEditor
+	MenuItemKeybinds
+	MenuItemChanges
+	MenuItemContrast
 )
 
 // NewMenuModel creates a new menu model.
 func NewMenuModel() MenuModel {
 	items := []list.Item{
-		MenuItem{title: "Browse Options", description: "Search Ghostty configuration options"},
-		MenuItem{title: "Config Editor ", description: "Edit your Ghostty config file directly"},
+		MenuItem{title: "Browse Options    ", description: "Search Ghostty configuration options"},
+		MenuItem{title: "Browse by Category", description: "Explore options grouped by what they affect"},
+		MenuItem{title: "My Config         ", description: "See every option you've set and where"},
+		MenuItem{title: "Config Editor     ", description: "Edit your Ghostty config file directly"},
+		MenuItem{title: "Keybinds          ", description: "Review your keybinds and their conflicts"},
+		MenuItem{title: "What's Changed    ", description: "Options added, removed or changed between Ghostty versions"},
+		MenuItem{title: "Contrast          ", description: "Check the readability of your colors"},
 	}
 
 	l := list.New(items, MenuItemDelegate{}, 0, 0)
@@ -101,8 +111,8 @@ func (m MenuModel) SetSize(width, height int) MenuModel {
 	logoHeight := 11
 	listHeight := height - logoHeight
 	// Ensure minimum height to show all menu items
-	if listHeight < 3 {
-		listHeight = 3
+	if listHeight < len(m.list.Items()) {
+		listHeight = len(m.list.Items())
 	}
 	m.list.SetHeight(listHeight)
 	return m
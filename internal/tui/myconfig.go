@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/intaek-h/ghofig/internal/config"
+)
+
+var (
+	myConfigTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(ThemePrimary)
+
+	myConfigCountStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	myConfigItemStyle = lipgloss.NewStyle().
+				PaddingLeft(2)
+
+	myConfigSelectedStyle = lipgloss.NewStyle().
+				Foreground(ThemePrimary)
+
+	myConfigValueStyle = lipgloss.NewStyle().
+				Foreground(ThemeSecondary)
+
+	myConfigLocationStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
+	myConfigErrorStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
+
+	myConfigHelpStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+)
+
+// myConfigMsg carries the options set in the user's config.
+type myConfigMsg struct {
+	entries []config.Entry
+	err     error
+}
+
+// MyConfigModel lists every option set in the user's config.
+type MyConfigModel struct {
+	entries []config.Entry
+	cursor  int
+	width   int
+	height  int
+	loaded  bool
+	message string // shown when an option can't be opened
+	err     error
+}
+
+// NewMyConfigModel creates a new config listing model.
+func NewMyConfigModel() MyConfigModel {
+	return MyConfigModel{}
+}
+
+// SetSize updates dimensions.
+func (m MyConfigModel) SetSize(width, height int) MyConfigModel {
+	m.width = width
+	m.height = height
+	return m
+}
+
+// Init loads the user's config.
+func (m MyConfigModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		entries, err := config.LoadEntries()
+		return myConfigMsg{entries: config.Effective(entries), err: err}
+	}
+}
+
+// Update handles updates.
+func (m MyConfigModel) Update(msg tea.Msg) (MyConfigModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case myConfigMsg:
+		m.entries = msg.entries
+		m.err = msg.err
+		m.loaded = true
+		m.cursor = min(m.cursor, max(len(m.entries)-1, 0))
+		return m, nil
+
+	case tea.KeyMsg:
+		m.message = ""
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.entries)-1 {
+				m.cursor++
+			}
+		}
+	}
+	return m, nil
+}
+
+// Selected returns the entry under the cursor.
+func (m MyConfigModel) Selected() *config.Entry {
+	if m.cursor < len(m.entries) {
+		return &m.entries[m.cursor]
+	}
+	return nil
+}
+
+// SetMessage shows a message below the list until the next key press.
+func (m MyConfigModel) SetMessage(message string) MyConfigModel {
+	m.message = message
+	return m
+}
+
+// View renders the config listing.
+func (m MyConfigModel) View() string {
+	titleLine := myConfigTitleStyle.Render("My Config")
+	if len(m.entries) > 0 {
+		titleLine += "  " + myConfigCountStyle.Render(fmt.Sprintf("%d settings", len(m.entries)))
+	}
+	header := titleLine + "\n"
+
+	footer := myConfigHelpStyle.Render("↑/↓: navigate • enter: view option • esc: back • q: quit")
+	if m.message != "" {
+		footer = myConfigErrorStyle.Render(m.message) + "\n" + footer
+	}
+
+	listHeight := m.height - lipgloss.Height(header) - lipgloss.Height(footer) - 1
+	if listHeight < 3 {
+		listHeight = 3
+	}
+
+	var content string
+	switch {
+	case m.err != nil:
+		content = myConfigErrorStyle.Render(fmt.Sprintf("Error reading config: %v", m.err))
+	case !m.loaded:
+		content = myConfigCountStyle.Render("Loading...")
+	case len(m.entries) == 0:
+		content = myConfigCountStyle.Render("Your config doesn't set any options")
+	default:
+		start := 0
+		if m.cursor >= listHeight {
+			start = m.cursor - listHeight + 1
+		}
+		end := min(start+listHeight, len(m.entries))
+
+		keyWidth := 0
+		for _, e := range m.entries {
+			keyWidth = max(keyWidth, len(e.Key))
+		}
+
+		var lines []string
+		for i := start; i < end; i++ {
+			lines = append(lines, m.renderEntry(i, keyWidth))
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	listSection := lipgloss.NewStyle().
+		Height(listHeight).
+		MaxHeight(listHeight).
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		listSection,
+		footer,
+	)
+}
+
+// renderEntry renders a single option row.
+func (m MyConfigModel) renderEntry(i, keyWidth int) string {
+	e := m.entries[i]
+
+	location := myConfigLocationStyle.Render(displayPath(e.File) + fmt.Sprintf(":%d", e.Line))
+	row := fmt.Sprintf("%-*s = %s  %s", keyWidth, e.Key, myConfigValueStyle.Render(e.Value), location)
+
+	if i == m.cursor {
+		return myConfigSelectedStyle.Render("➤ ") + row
+	}
+	return myConfigItemStyle.Render(row)
+}
+
+// displayPath shortens paths in the home directory to "~/...".
+func displayPath(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return path
+	}
+	if rest, ok := strings.CutPrefix(path, home); ok && strings.HasPrefix(rest, string(os.PathSeparator)) {
+		return "~" + rest
+	}
+	return path
+}
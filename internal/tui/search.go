@@ -2,11 +2,15 @@ package tui
 
 import (
 	"fmt"
+	"runtime"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/intaek-h/ghofig/internal/config"
 	"github.com/intaek-h/ghofig/internal/db"
 	"github.com/intaek-h/ghofig/internal/model"
 )
@@ -35,6 +39,9 @@ var (
 				Foreground(ThemeTextMuted).
 				PaddingLeft(2)
 
+	searchSnippetStyle = lipgloss.NewStyle().
+				Foreground(ThemeTextMuted)
+
 	searchMatchStyle = lipgloss.NewStyle().
 				Foreground(ThemeMatch).
 				Bold(true)
@@ -43,19 +50,113 @@ var (
 					Foreground(ThemeMatch).
 					Bold(true)
 
+	searchSetStyle = lipgloss.NewStyle().
+			Foreground(ThemeSuccess)
+
+	searchNewerStyle = lipgloss.NewStyle().
+				Foreground(ThemeWarning)
+
+	searchValueStyle = lipgloss.NewStyle().
+				Foreground(ThemeSecondary)
+
 	searchHelpStyle = lipgloss.NewStyle().
 			Foreground(ThemeTextMuted)
+
+	searchPreviewStyle = lipgloss.NewStyle().
+				Border(lipgloss.NormalBorder(), false, false, false, true).
+				BorderForeground(ThemeTextMuted).
+				PaddingLeft(2)
+
+	searchChipStyle = lipgloss.NewStyle().
+			Foreground(ThemeSecondary).
+			Background(ThemeBgHighlight).
+			Padding(0, 1)
+
+	searchErrorStyle = lipgloss.NewStyle().
+				Foreground(ThemeError)
 )
 
+// searchScope selects what the search view searches.
+type searchScope int
+
+const (
+	scopeOptions searchScope = iota
+	scopeActions
+)
+
+// setFilter narrows option results by whether the user has set them.
+type setFilter int
+
+const (
+	filterAll setFilter = iota
+	filterSet
+	filterUnset
+)
+
+// String returns the label shown in the title line.
+func (f setFilter) String() string {
+	switch f {
+	case filterSet:
+		return "only set"
+	case filterUnset:
+		return "only unset"
+	default:
+		return ""
+	}
+}
+
+// is returns the filter as the value of an is: query filter.
+func (f setFilter) is() string {
+	switch f {
+	case filterSet:
+		return "set"
+	case filterUnset:
+		return "unset"
+	default:
+		return ""
+	}
+}
+
+// currentPlatform is the platform options are filtered for.
+var currentPlatform = model.Platform(runtime.GOOS)
+
+// maxInlineValue is how much of a set value is shown next to its option.
+const maxInlineValue = 40
+
+// minPreviewWidth is the terminal width from which the selected result's
+// description is previewed next to the results.
+const minPreviewWidth = 110
+
+// maxPreviewValues is how many values of a repeatable option are previewed.
+const maxPreviewValues = 5
+
+// searchDebounce is how long typing has to pause before searching.
+const searchDebounce = 80 * time.Millisecond
+
 // SearchModel represents the search view.
 type SearchModel struct {
-	input   textinput.Model
-	results []model.Config
-	cursor  int
-	query   string
-	width   int
-	height  int
-	err     error
+	input  textinput.Model
+	scope  searchScope
+	filter setFilter
+	// allPlatforms shows options that don't apply to this platform, for
+	// configs shared between machines
+	allPlatforms bool
+	// grouped collapses options sharing a description, like the
+	// font-family variants, into their first result
+	grouped  bool
+	all      []model.Config      // option results before filtering
+	results  []model.Config      // option results shown
+	values   map[string][]string // values of the options set in the config
+	variants map[int]int         // results hidden under each group when grouped
+	actions  []model.Action
+	cursor   int
+	seq      int // number of the latest search; results of earlier ones are dropped
+	query    string
+	parsed   db.Query        // query split into text and filters, for options
+	synonyms []model.Synonym // synonyms in the query, shown expanded
+	width    int
+	height   int
+	err      error
 }
 
 // NewSearchModel creates a new search model.
@@ -75,6 +176,15 @@ func NewSearchModel() SearchModel {
 	}
 }
 
+// Reset returns an empty search view of the same size. Searches keep
+// being numbered after this view's, so results of its searches that
+// arrive late are still dropped.
+func (m SearchModel) Reset() SearchModel {
+	reset := NewSearchModel().SetSize(m.width, m.height)
+	reset.seq = m.seq
+	return reset
+}
+
 // SetSize updates dimensions.
 func (m SearchModel) SetSize(width, height int) SearchModel {
 	m.width = width
@@ -88,26 +198,141 @@ func (m SearchModel) Init() tea.Cmd {
 	return textinput.Blink
 }
 
+// searchDebounceMsg starts search seq once typing pauses, unless another
+// search has been started since.
+type searchDebounceMsg struct {
+	seq int
+}
+
 // searchResultMsg carries search results.
 type searchResultMsg struct {
-	results []model.Config
-	query   string
-	err     error
+	seq      int
+	results  []model.Config
+	values   map[string][]string
+	actions  []model.Action
+	scope    searchScope
+	query    string
+	parsed   db.Query
+	synonyms []model.Synonym
+	err      error
+}
+
+// nextSearch returns the model with a new search number, so results of
+// searches already running are dropped.
+func (m SearchModel) nextSearch() SearchModel {
+	m.seq++
+	return m
 }
 
-// doSearch returns a command that searches the database.
-func doSearch(query string) tea.Cmd {
+// debounceSearch returns a command that starts search seq after
+// searchDebounce.
+func debounceSearch(seq int) tea.Cmd {
+	return tea.Tick(searchDebounce, func(time.Time) tea.Msg {
+		return searchDebounceMsg{seq: seq}
+	})
+}
+
+// search returns a command that searches the database for the input.
+// The set/unset and platform filters are passed into the query, so the
+// search isn't cut short before they're applied. A platform: in the query
+// wins over the current platform.
+func (m SearchModel) search() tea.Cmd {
+	query, scope, seq, filter := m.input.Value(), m.scope, m.seq, m.filter
+	platform := currentPlatform
+	if m.allPlatforms {
+		platform = ""
+	}
 	return func() tea.Msg {
-		results, err := db.Search(query)
-		return searchResultMsg{results: results, query: query, err: err}
+		if scope == scopeActions {
+			actions, err := db.SearchActions(query)
+			return searchResultMsg{seq: seq, actions: actions, scope: scope, query: query, err: err}
+		}
+		parsed, err := db.ParseQuery(query)
+		if err != nil {
+			return searchResultMsg{seq: seq, scope: scope, query: query, err: err}
+		}
+		q := parsed
+		if q.Is == "" {
+			q.Is = filter.is()
+		}
+		if q.Platform == "" {
+			q.Platform = platform
+		}
+		results, err := db.SearchQuery(q)
+		if err != nil {
+			return searchResultMsg{seq: seq, scope: scope, query: query, err: err}
+		}
+		synonyms, err := db.Synonyms(parsed.Text)
+		return searchResultMsg{seq: seq, results: results, values: loadSetValues(), scope: scope, query: query, parsed: parsed, synonyms: synonyms, err: err}
 	}
 }
 
+// loadSetValues parses the user's config once and returns the effective
+// values of each option it sets. A config that can't be read counts as
+// setting nothing.
+func loadSetValues() map[string][]string {
+	entries, err := config.LoadEntries()
+	if err != nil {
+		return nil
+	}
+
+	values := map[string][]string{}
+	for _, e := range config.Effective(entries) {
+		values[e.Key] = append(values[e.Key], e.Value)
+	}
+	return values
+}
+
+// applyFilter narrows the option results down to the current filters.
+func (m SearchModel) applyFilter() SearchModel {
+	m.results = nil
+	m.variants = map[int]int{}
+	for _, r := range m.all {
+		_, set := m.values[r.Title]
+		if m.filter != filterAll && set != (m.filter == filterSet) {
+			continue
+		}
+		if m.parsed.Is != "" && set != (m.parsed.Is == "set") {
+			continue
+		}
+		if m.grouped && r.GroupID != 0 {
+			if _, seen := m.variants[r.GroupID]; seen {
+				m.variants[r.GroupID]++
+				continue
+			}
+			m.variants[r.GroupID] = 0
+		}
+		m.results = append(m.results, r)
+	}
+	return m
+}
+
 // Update handles updates.
 func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	switch msg := msg.(type) {
+	case searchDebounceMsg:
+		if msg.seq != m.seq {
+			return m, nil
+		}
+		return m, m.search()
+
 	case searchResultMsg:
-		m.results = msg.results
+		if msg.seq != m.seq || msg.scope != m.scope {
+			// Results of an earlier search that finished late
+			return m, nil
+		}
+		if msg.err != nil && msg.results == nil {
+			// Keep the last results while the query can't be parsed
+			m.query = msg.query
+			m.err = msg.err
+			return m, nil
+		}
+		m.parsed = msg.parsed
+		m.synonyms = msg.synonyms
+		m.all = msg.results
+		m.values = msg.values
+		m.actions = msg.actions
+		m = m.applyFilter()
 		m.query = msg.query
 		m.err = msg.err
 		m.cursor = 0
@@ -116,12 +341,66 @@ func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	case tea.KeyMsg:
 		key := msg.String()
 
+		if key == "tab" {
+			// Switch between searching options and keybind actions
+			if m.scope == scopeOptions {
+				m.scope = scopeActions
+			} else {
+				m.scope = scopeOptions
+			}
+			m.all = nil
+			m.results = nil
+			m.actions = nil
+			m.cursor = 0
+			m.parsed = db.Query{}
+			m.synonyms = nil
+			m.err = nil
+			m = m.nextSearch()
+			if m.input.Value() == "" {
+				m.query = ""
+				return m, nil
+			}
+			return m, m.search()
+		}
+
+		if key == "ctrl+f" && m.scope == scopeOptions {
+			// Cycle between all, only set and only unset options
+			m.filter = (m.filter + 1) % 3
+			m = m.applyFilter()
+			m.cursor = 0
+			if m.input.Value() == "" {
+				return m, nil
+			}
+			// Search again, as filtered searches aren't limited
+			m = m.nextSearch()
+			return m, m.search()
+		}
+
+		if key == "ctrl+p" && m.scope == scopeOptions {
+			// Toggle options for other platforms
+			m.allPlatforms = !m.allPlatforms
+			m.cursor = 0
+			if m.input.Value() == "" {
+				return m, nil
+			}
+			m = m.nextSearch()
+			return m, m.search()
+		}
+
+		if key == "ctrl+g" && m.scope == scopeOptions {
+			// Toggle collapsing option groups into one row
+			m.grouped = !m.grouped
+			m = m.applyFilter()
+			m.cursor = 0
+			return m, nil
+		}
+
 		if key == "up" || key == "down" {
-			if len(m.results) > 0 {
+			if count := m.resultCount(); count > 0 {
 				m.input.Blur()
 				if key == "up" && m.cursor > 0 {
 					m.cursor--
-				} else if key == "down" && m.cursor < len(m.results)-1 {
+				} else if key == "down" && m.cursor < count-1 {
 					m.cursor++
 				}
 			}
@@ -143,46 +422,64 @@ func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 
 	if m.input.Value() != prevValue {
 		m.cursor = 0
-		newQuery := m.input.Value()
-		if newQuery == "" {
+		m = m.nextSearch()
+		if m.input.Value() == "" {
+			m.all = nil
 			m.results = nil
+			m.actions = nil
 			m.query = ""
+			m.parsed = db.Query{}
+			m.synonyms = nil
+			m.err = nil
 			return m, cmd
 		}
-		return m, tea.Batch(cmd, doSearch(newQuery))
+		return m, tea.Batch(cmd, debounceSearch(m.seq))
 	}
 
 	return m, cmd
 }
 
-// highlightWithStyle highlights query matches in text, applying baseStyle to non-match parts.
-func highlightWithStyle(text, query string, baseStyle, matchStyle lipgloss.Style) string {
-	if query == "" {
-		return baseStyle.Render(text)
+// indexFold returns the byte range of the first case-insensitive match of
+// substr in s, or -1, -1. Offsets are into s itself, as lowercasing can
+// change how many bytes a character takes.
+func indexFold(s, substr string) (start, end int) {
+	if substr == "" {
+		return -1, -1
 	}
-
-	lower := strings.ToLower(text)
-	lowerQ := strings.ToLower(query)
-	idx := strings.Index(lower, lowerQ)
-	if idx == -1 {
-		return baseStyle.Render(text)
+	for start = range s {
+		end = start
+		matched := true
+		for _, want := range substr {
+			got, size := utf8.DecodeRuneInString(s[end:])
+			if size == 0 || !strings.EqualFold(string(got), string(want)) {
+				matched = false
+				break
+			}
+			end += size
+		}
+		if matched {
+			return start, end
+		}
 	}
+	return -1, -1
+}
 
+// highlightWithStyle highlights query matches in text, applying baseStyle to non-match parts.
+func highlightWithStyle(text, query string, baseStyle, matchStyle lipgloss.Style) string {
 	var b strings.Builder
 	last := 0
-	for idx != -1 {
-		if last < idx {
-			b.WriteString(baseStyle.Render(text[last:idx]))
-		}
-		b.WriteString(matchStyle.Render(text[idx : idx+len(query)]))
-		last = idx + len(query)
-		next := strings.Index(lower[last:], lowerQ)
-		if next == -1 {
+	for {
+		start, end := indexFold(text[last:], query)
+		if start == -1 {
 			break
 		}
-		idx = last + next
+		if start > 0 {
+			b.WriteString(baseStyle.Render(text[last : last+start]))
+		}
+		b.WriteString(matchStyle.Render(text[last+start : last+end]))
+		last += end
 	}
-	if last < len(text) {
+	if last < len(text) || last == 0 {
 		b.WriteString(baseStyle.Render(text[last:]))
 	}
 	return b.String()
@@ -191,29 +488,69 @@ func highlightWithStyle(text, query string, baseStyle, matchStyle lipgloss.Style
 // View renders the search view.
 func (m SearchModel) View() string {
 	// Build title line with count
+	title := "Search"
+	if m.scope == scopeActions {
+		title = "Search Keybind Actions"
+	}
+
+	if m.scope == scopeOptions {
+		var labels []string
+		if label := m.filter.String(); label != "" {
+			labels = append(labels, label)
+		}
+		if m.allPlatforms {
+			labels = append(labels, "all platforms")
+		}
+		if m.grouped {
+			labels = append(labels, "grouped")
+		}
+		if len(labels) > 0 {
+			title += " (" + strings.Join(labels, ", ") + ")"
+		}
+	}
+
 	var titleLine string
 	if m.query != "" {
 		current := 0
-		if len(m.results) > 0 {
+		if m.resultCount() > 0 {
 			current = m.cursor + 1
 		}
-		titleLine = searchTitleStyle.Render("Search") + "  " + searchCountStyle.Render(fmt.Sprintf("%d/%d results", current, len(m.results)))
+		titleLine = searchTitleStyle.Render(title) + "  " + searchCountStyle.Render(fmt.Sprintf("%d/%d results", current, m.resultCount()))
 	} else {
-		titleLine = searchTitleStyle.Render("Search")
+		titleLine = searchTitleStyle.Render(title)
 	}
 
 	// Build input line with prompt
 	inputLine := searchPromptStyle.Render("> ") + m.input.View()
 
+	// Show why the query can't be parsed, or the filters it applies
+	if m.err != nil {
+		inputLine += "\n" + searchErrorStyle.Render(m.err.Error())
+	} else if filters := m.parsed.Filters(); m.scope == scopeOptions && len(filters) > 0 {
+		chips := make([]string, len(filters))
+		for i, f := range filters {
+			chips[i] = searchChipStyle.Render(f)
+		}
+		inputLine += "\n" + strings.Join(chips, " ")
+	}
+	if m.err == nil && m.scope == scopeOptions && len(m.synonyms) > 0 {
+		// Show which options the query's synonyms stand for
+		expanded := make([]string, len(m.synonyms))
+		for i, s := range m.synonyms {
+			expanded[i] = s.Phrase + " → " + strings.Join(s.Titles, ", ")
+		}
+		inputLine += "\n" + searchCountStyle.MaxWidth(m.width).Render(strings.Join(expanded, " • "))
+	}
+
 	// Combined header with blank line between title and input, and between input and list
 	header := titleLine + "\n\n" + inputLine + "\n"
 
 	// Build help footer
 	var helpText string
 	if m.query == "" {
-		helpText = "type to search • esc: back • q: quit"
+		helpText = "type to search, filter with platform: type: since: category: is: • tab: options/actions • ctrl+f: set/unset • ctrl+p: platforms • ctrl+g: group • esc: back • q: quit"
 	} else {
-		helpText = "↑/↓: navigate • enter: select • esc: back • q: quit"
+		helpText = "↑/↓: navigate • enter: select • tab: options/actions • ctrl+f: set/unset • ctrl+p: platforms • ctrl+g: group • esc: back • q: quit"
 	}
 	footer := searchHelpStyle.Render(helpText)
 
@@ -228,39 +565,17 @@ func (m SearchModel) View() string {
 
 	// Build results section
 	var resultsContent string
-	if m.query != "" && len(m.results) > 0 {
+	if m.query != "" && m.resultCount() > 0 {
 		var lines []string
 
-		availableForItems := resultsHeight // items are single line now
-		maxItems := availableForItems
-		if maxItems < 1 {
-			maxItems = 1
-		}
-
-		// Calculate window
-		start := 0
-		if m.cursor >= maxItems {
-			start = m.cursor - maxItems + 1
-		}
-		end := start + maxItems
-		if end > len(m.results) {
-			end = len(m.results)
-		}
-
-		// Render items (title only, no description)
+		start, end := m.window(resultsHeight)
 		for i := start; i < end; i++ {
-			r := m.results[i]
-			title := r.Title
-
-			if i == m.cursor {
-				// Selected item: apply primary color to non-match text
-				titleStyled := highlightWithStyle(title, m.query, lipgloss.NewStyle().Foreground(ThemePrimary), searchSelectedMatchStyle)
-				lines = append(lines, searchSelectedStyle.Render("\u27a4 \u25cb "+titleStyled))
-			} else {
-				// Unselected item: no base color, just match highlights
-				titleStyled := highlightWithStyle(title, m.query, lipgloss.NewStyle(), searchMatchStyle)
-				lines = append(lines, searchItemStyle.Render("\u25cb "+titleStyled))
+			if m.scope == scopeActions {
+				lines = append(lines, m.renderAction(i))
+				continue
 			}
+
+			lines = append(lines, m.renderOption(i))
 		}
 
 		resultsContent = strings.Join(lines, "\n")
@@ -274,6 +589,20 @@ func (m SearchModel) View() string {
 		MaxHeight(resultsHeight).
 		Render(resultsContent)
 
+	// Preview the selected result beside the results on wide terminals
+	if m.width >= minPreviewWidth && m.query != "" && m.resultCount() > 0 {
+		listWidth := m.width * 2 / 5
+		resultsSection = lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().
+				Width(listWidth).
+				MaxWidth(listWidth).
+				Height(resultsHeight).
+				MaxHeight(resultsHeight).
+				Render(resultsContent),
+			m.renderPreview(m.width-listWidth-3, resultsHeight),
+		)
+	}
+
 	// Join all sections vertically
 	return lipgloss.JoinVertical(lipgloss.Left,
 		header,
@@ -282,6 +611,171 @@ func (m SearchModel) View() string {
 	)
 }
 
+// itemHeight returns how many lines result i takes.
+func (m SearchModel) itemHeight(i int) int {
+	if m.scope == scopeOptions && m.results[i].Snippet != "" {
+		return 2
+	}
+	return 1
+}
+
+// window returns the range of results that fit in height lines with the
+// cursor in view.
+func (m SearchModel) window(height int) (start, end int) {
+	used := 0
+	for i := 0; i <= m.cursor; i++ {
+		used += m.itemHeight(i)
+	}
+	if used > height {
+		// Scroll so the cursor is the last result shown
+		start = m.cursor
+		used = m.itemHeight(start)
+		for start > 0 && used+m.itemHeight(start-1) <= height {
+			start--
+			used += m.itemHeight(start)
+		}
+	}
+
+	end = start
+	used = 0
+	for end < m.resultCount() && (end == start || used+m.itemHeight(end) <= height) {
+		used += m.itemHeight(end)
+		end++
+	}
+	return start, end
+}
+
+// renderOption renders an option result. Options set in the config get
+// a filled marker and their current value.
+func (m SearchModel) renderOption(i int) string {
+	r := m.results[i]
+
+	marker := "\u25cb"
+	value := ""
+	if values, ok := m.values[r.Title]; ok {
+		marker = searchSetStyle.Render("\u25cf")
+		value = " " + searchValueStyle.Render("= "+inlineValue(values))
+	}
+	badge := platformBadge(r)
+	if badge != "" {
+		badge = " " + searchCountStyle.Render(badge)
+	}
+	if !ghosttyVersion.Supports(r.Since) {
+		badge += " " + searchNewerStyle.Render("["+r.Since+"+]")
+	}
+	if hidden := m.variants[r.GroupID]; m.grouped && hidden > 0 {
+		badge += " " + searchCountStyle.Render(fmt.Sprintf("+%d variants", hidden))
+	}
+
+	snippet := ""
+	if r.Snippet != "" {
+		// Show why a description-only match matched
+		text := r.Snippet
+		if runes := []rune(text); m.width > 8 && len(runes) > m.width-8 {
+			text = string(runes[:m.width-9]) + "…"
+		}
+		snippet = "\n" + searchDescStyle.Render(highlightWithStyle(text, m.parsed.Text, searchSnippetStyle, searchMatchStyle))
+	}
+
+	if i == m.cursor {
+		// Selected item: apply primary color to non-match text
+		titleStyled := highlightWithStyle(r.Title, m.parsed.Text, lipgloss.NewStyle().Foreground(ThemePrimary), searchSelectedMatchStyle)
+		return searchSelectedStyle.Render("\u27a4 "+marker+" "+titleStyled+badge+value) + snippet
+	}
+	// Unselected item: no base color, just match highlights
+	titleStyled := highlightWithStyle(r.Title, m.parsed.Text, lipgloss.NewStyle(), searchMatchStyle)
+	return searchItemStyle.Render(marker+" "+titleStyled+badge+value) + snippet
+}
+
+// renderPreview renders the selected result's description, and for
+// options the values set in the config, to fit width and height.
+func (m SearchModel) renderPreview(width, height int) string {
+	var title, description string
+	var values []string
+	if a := m.SelectedAction(); a != nil {
+		title = a.Name
+		description = a.Description
+		if a.Parameters != "" {
+			description = fmt.Sprintf("Parameters: %s\n\n%s", a.Parameters, description)
+		}
+	} else if c := m.SelectedConfig(); c != nil {
+		title = c.Title
+		description = c.Description
+		values = m.values[c.Title]
+	}
+
+	lines := []string{searchTitleStyle.Render(title)}
+	for i, v := range values {
+		if i == maxPreviewValues {
+			lines = append(lines, searchCountStyle.Render(fmt.Sprintf("(+%d more)", len(values)-i)))
+			break
+		}
+		lines = append(lines, searchValueStyle.Render("= "+v))
+	}
+	lines = append(lines, "")
+	lines = append(lines, strings.Split(renderMarkdown(description, width-2), "\n")...)
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+
+	return searchPreviewStyle.
+		Width(width).
+		MaxWidth(width + 1).
+		Height(height).
+		Render(strings.Join(lines, "\n"))
+}
+
+// platformBadge returns "[macOS]" style labels for options limited to
+// some platforms, or "" for options that work everywhere.
+func platformBadge(c model.Config) string {
+	if len(c.Platforms) == 0 {
+		return ""
+	}
+	labels := make([]string, len(c.Platforms))
+	for i, p := range c.Platforms {
+		labels[i] = model.PlatformLabel(p)
+	}
+	return "[" + strings.Join(labels, ", ") + "]"
+}
+
+// inlineValue formats an option's values to fit next to its title.
+// Repeatable options show their last value and how many there are.
+func inlineValue(values []string) string {
+	value := values[len(values)-1]
+	if len(values) > 1 {
+		value = fmt.Sprintf("%s (+%d more)", value, len(values)-1)
+	}
+	if runes := []rune(value); len(runes) > maxInlineValue {
+		value = string(runes[:maxInlineValue-1]) + "…"
+	}
+	return value
+}
+
+// renderAction renders a keybind action result with its parameters.
+func (m SearchModel) renderAction(i int) string {
+	a := m.actions[i]
+
+	params := ""
+	if a.Parameters != "" {
+		params = " " + searchCountStyle.Render(":"+a.Parameters)
+	}
+
+	if i == m.cursor {
+		nameStyled := highlightWithStyle(a.Name, m.query, lipgloss.NewStyle().Foreground(ThemePrimary), searchSelectedMatchStyle)
+		return searchSelectedStyle.Render("\u27a4 \u25cb " + nameStyled + params)
+	}
+	nameStyled := highlightWithStyle(a.Name, m.query, lipgloss.NewStyle(), searchMatchStyle)
+	return searchItemStyle.Render("\u25cb " + nameStyled + params)
+}
+
+// resultCount returns the number of results in the current scope.
+func (m SearchModel) resultCount() int {
+	if m.scope == scopeActions {
+		return len(m.actions)
+	}
+	return len(m.results)
+}
+
 // IsInputFocused returns whether input is focused.
 func (m SearchModel) IsInputFocused() bool {
 	return m.input.Focused()
@@ -294,15 +788,23 @@ func (m SearchModel) InputValue() string {
 
 // SelectedConfig returns the selected config.
 func (m SearchModel) SelectedConfig() *model.Config {
-	if len(m.results) > 0 && m.cursor >= 0 && m.cursor < len(m.results) {
+	if m.scope == scopeOptions && len(m.results) > 0 && m.cursor >= 0 && m.cursor < len(m.results) {
 		return &m.results[m.cursor]
 	}
 	return nil
 }
 
+// SelectedAction returns the selected keybind action.
+func (m SearchModel) SelectedAction() *model.Action {
+	if m.scope == scopeActions && len(m.actions) > 0 && m.cursor >= 0 && m.cursor < len(m.actions) {
+		return &m.actions[m.cursor]
+	}
+	return nil
+}
+
 // HasResults returns whether there are search results.
 func (m SearchModel) HasResults() bool {
-	return len(m.results) > 0
+	return m.resultCount() > 0
 }
 
 func min(a, b int) int {
@@ -0,0 +1,24 @@
+package tui
+
+import "testing"
+
+func TestIndexFold(t *testing.T) {
+	tests := []struct {
+		s, substr  string
+		start, end int
+	}{
+		{"Background Opacity", "opacity", 11, 18},
+		{"no match", "blur", -1, -1},
+		{"anything", "", -1, -1},
+		// "ẞ" lowercases to "ß", which is a byte shorter
+		{"STRAẞE opacity", "opacity", 9, 16},
+		{"straẞe", "straße", 0, 8},
+		{"KELVIN", "kelvin", 0, 6},
+	}
+	for _, tt := range tests {
+		start, end := indexFold(tt.s, tt.substr)
+		if start != tt.start || end != tt.end {
+			t.Errorf("indexFold(%q, %q) = %d, %d, want %d, %d", tt.s, tt.substr, start, end, tt.start, tt.end)
+		}
+	}
+}